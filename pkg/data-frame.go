@@ -2,6 +2,7 @@ package main
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/grafana/grafana-plugin-sdk-go/backend/log"
 	"github.com/grafana/grafana-plugin-sdk-go/data"
@@ -68,3 +69,58 @@ func addFrameFieldsFromArray(values []interface{}, frame *data.Frame) *data.Fram
 
 	return frame
 }
+
+/**
+ * Parse reply with field and value pairs to map
+ */
+func parseMapReply(values []interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	for i := 0; i+1 < len(values); i += 2 {
+		switch key := values[i].(type) {
+		case []byte:
+			result[string(key)] = values[i+1]
+		case string:
+			result[key] = values[i+1]
+		}
+	}
+
+	return result
+}
+
+/**
+ * Convert reply value to string, arrays are joined with comma
+ */
+func replyToString(value interface{}) string {
+	switch v := value.(type) {
+	case []byte:
+		return string(v)
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, item := range v {
+			values[i] = replyToString(item)
+		}
+		return strings.Join(values, ",")
+	default:
+		return ""
+	}
+}
+
+/**
+ * Check if slice contains value
+ */
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
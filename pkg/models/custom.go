@@ -4,5 +4,6 @@ package models
  * Custom Commands
  */
 const (
-	TMScan = "tmscan"
+	TMScan        = "tmscan"
+	TSCardinality = "tscardinality"
 )
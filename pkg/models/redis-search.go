@@ -4,10 +4,79 @@ package models
  * RediSearch Commands
  */
 const (
-	SearchInfo = "ft.info"
-	Search     = "ft.search"
+	SearchInfo       = "ft.info"
+	Search           = "ft.search"
+	SearchAggregate  = "ft.aggregate"
+	SearchExplain    = "ft.explain"
+	SearchExplainCli = "ft.explaincli"
+	SearchProfile    = "ft.profile"
+	SearchList       = "ft._list"
+	SearchTagVals    = "ft.tagvals"
+	SearchSugGet     = "ft.sugget"
+	SearchSpellCheck = "ft.spellcheck"
+	SearchCursor     = "ft.cursor"
 )
 
+/**
+ * FT.PROFILE commands
+ */
+const (
+	SearchProfileSearch    = "SEARCH"
+	SearchProfileAggregate = "AGGREGATE"
+)
+
+/**
+ * FT.AGGREGATE pipeline steps
+ */
+const (
+	SearchStepLoad    = "load"
+	SearchStepGroupBy = "groupby"
+	SearchStepApply   = "apply"
+	SearchStepFilter  = "filter"
+	SearchStepSortBy  = "sortby"
+	SearchStepLimit   = "limit"
+)
+
+/**
+ * Field types
+ */
+const (
+	SearchFieldText    = "TEXT"
+	SearchFieldTag     = "TAG"
+	SearchFieldNumeric = "NUMERIC"
+	SearchFieldGeo     = "GEO"
+	SearchFieldVector  = "VECTOR"
+)
+
+/**
+ * Query parameter types
+ */
+const (
+	SearchParamText    = "text"
+	SearchParamFloat32 = "float32"
+	SearchParamFloat64 = "float64"
+)
+
+/**
+ * Units of the timestamp field
+ */
+const (
+	SearchTimeUnitSeconds      = "s"
+	SearchTimeUnitMilliseconds = "ms"
+)
+
+/**
+ * Default idle time in milliseconds before the FT.AGGREGATE cursor is deleted
+ *
+ * Shorter than the RediSearch default to clean up cursors abandoned by refreshed dashboards.
+ */
+const SearchCursorMaxIdle = 60000
+
+/**
+ * Property with the time bucket in FT.AGGREGATE
+ */
+const SearchTimeProperty = "__time"
+
 /**
  * FT.INFO field configuration
  */
@@ -18,4 +87,31 @@ var SearchInfoConfig = map[string]string{
 	"sortable_values_size_mb": "decmbytes",
 	"key_table_size_mb":       "decmbytes",
 	"percent_indexed":         "percentunit",
+	"bytes_collected":         "decbytes",
+	"total_ms_run":            "ms",
+	"average_cycle_time_ms":   "ms",
+	"last_run_time_ms":        "ms",
+	"vector_index_sz_mb":      "decmbytes",
 }
+
+/**
+ * FT.INFO fields returned in the overview of indexes
+ */
+var SearchListFields = []string{
+	"num_docs",
+	"num_terms",
+	"num_records",
+	"inverted_sz_mb",
+	"vector_index_sz_mb",
+	"doc_table_size_mb",
+	"sortable_values_size_mb",
+	"key_table_size_mb",
+	"percent_indexed",
+	"indexing_failures",
+	"hash_indexing_failures",
+}
+
+/**
+ * FT.INFO statistics returned as separate frames
+ */
+var SearchInfoStats = []string{"gc_stats", "cursor_stats", "dialect_stats"}
@@ -0,0 +1,26 @@
+package models
+
+/**
+ * Redis Sentinel Commands
+ */
+const (
+	SentinelCKQuorum  = "sentinelCkquorum"
+	SentinelMasters   = "sentinelMasters"
+	SentinelReplicas  = "sentinelReplicas"
+	SentinelSentinels = "sentinelSentinels"
+)
+
+/**
+ * SENTINEL MASTERS, REPLICAS and SENTINELS field configuration
+ */
+var SentinelFieldConfig = map[string]string{
+	"last-ping-sent":          "ms",
+	"last-ok-ping-reply":      "ms",
+	"last-ping-reply":         "ms",
+	"down-after-milliseconds": "ms",
+	"info-refresh":            "ms",
+	"role-reported-time":      "ms",
+	"failover-timeout":        "ms",
+	"master-link-down-time":   "ms",
+	"last-hello-message":      "ms",
+}
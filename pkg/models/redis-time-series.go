@@ -10,4 +10,58 @@ const (
 	TimeSeriesQueryIndex = "ts.queryindex"
 	TimeSeriesRange      = "ts.range"
 	TimeSeriesMRange     = "ts.mrange"
+	TimeSeriesRevRange   = "ts.revrange"
+	TimeSeriesMRevRange  = "ts.mrevrange"
 )
+
+/**
+ * Output formats for multiple time-series
+ */
+const (
+	TimeSeriesFormatMulti = "multi"
+	TimeSeriesFormatWide  = "wide"
+	TimeSeriesFormatLong  = "long"
+)
+
+/**
+ * Expressions over time-series
+ */
+const (
+	TimeSeriesExpressionRate          = "rate"
+	TimeSeriesExpressionDelta         = "delta"
+	TimeSeriesExpressionMovingAverage = "moving_avg"
+	TimeSeriesExpressionRatio         = "ratio"
+	TimeSeriesExpressionPercentile    = "percentile"
+)
+
+/**
+ * Fill modes for missing intervals
+ */
+const (
+	TimeSeriesFillNull     = "null"
+	TimeSeriesFillZero     = "zero"
+	TimeSeriesFillPrevious = "previous"
+	TimeSeriesFillLinear   = "linear"
+	TimeSeriesFillValue    = "value"
+)
+
+/**
+ * Time buckets in milliseconds to round automatic time bucket
+ */
+var TimeSeriesAutoBuckets = []int64{
+	1, 2, 5, 10, 20, 50, 100, 200, 500,
+	1000, 2000, 5000, 10000, 15000, 30000,
+	60000, 120000, 300000, 600000, 900000, 1800000,
+	3600000, 7200000, 10800000, 21600000, 43200000,
+	86400000, 172800000, 604800000,
+}
+
+/**
+ * Number of TS.INFO commands in the pipeline for cardinality
+ */
+const TimeSeriesCardinalityBatchSize = 1000
+
+/**
+ * Maximum number of intervals to fill across the time range
+ */
+const TimeSeriesFillMaxPoints = 100000
@@ -47,9 +47,11 @@ func query(ctx context.Context, query backend.DataQuery, client redisClient, qm
 		return queryTsInfo(qm, client)
 	case models.TimeSeriesQueryIndex:
 		return queryTsQueryIndex(qm, client)
-	case models.TimeSeriesRange:
+	case models.TimeSeriesRange, models.TimeSeriesRevRange:
+		qm.Bucket = tsBucket(query, qm)
 		return queryTsRange(from, to, qm, client)
-	case models.TimeSeriesMRange:
+	case models.TimeSeriesMRange, models.TimeSeriesMRevRange:
+		qm.Bucket = tsBucket(query, qm)
 		return queryTsMRange(from, to, qm, client)
 
 	/**
@@ -95,6 +97,38 @@ func query(ctx context.Context, query backend.DataQuery, client redisClient, qm
 		return queryClusterInfo(qm, client)
 	case models.ClusterNodes:
 		return queryClusterNodes(qm, client)
+	case models.ClusterGraph:
+		return queryClusterNodesGraph(qm, client)
+	case models.ClusterShards:
+		return queryClusterShards(qm, client)
+	case models.ClusterSlots:
+		return queryClusterSlots(qm, client)
+
+	/**
+	 * Access Control List
+	 */
+	case models.ACLLog:
+		return queryACLLog(qm, client)
+	case models.ACLList:
+		return queryACLList(qm, client)
+
+	/**
+	 * Sentinel
+	 */
+	case models.SentinelMasters:
+		return querySentinelMasters(qm, client)
+	case models.SentinelReplicas:
+		return querySentinelInstances(qm, client, "REPLICAS")
+	case models.SentinelSentinels:
+		return querySentinelInstances(qm, client, "SENTINELS")
+	case models.SentinelCKQuorum:
+		return querySentinelCKQuorum(qm, client)
+
+	/**
+	 * Configuration
+	 */
+	case models.ConfigGet:
+		return queryConfigGet(qm, client)
 
 	/**
 	 * RediSearch
@@ -103,13 +137,31 @@ func query(ctx context.Context, query backend.DataQuery, client redisClient, qm
 		return queryFtInfo(qm, client)
 
 	case models.Search:
-		return queryFtSearch(qm, client)
+		return queryFtSearch(from, to, qm, client)
+	case models.SearchAggregate:
+		qm.Bucket = tsBucket(query, qm)
+		return queryFtAggregate(from, to, qm, client)
+	case models.SearchList:
+		return queryFtList(qm, client)
+	case models.SearchTagVals:
+		return queryFtTagVals(qm, client)
+	case models.SearchSugGet:
+		return queryFtSugGet(qm, client)
+	case models.SearchSpellCheck:
+		return queryFtSpellCheck(qm, client)
+	case models.SearchExplain, models.SearchExplainCli:
+		return queryFtExplain(qm, client)
+	case models.SearchProfile:
+		qm.Bucket = tsBucket(query, qm)
+		return queryFtProfile(from, to, qm, client)
 
 	/**
 	 * Custom commands
 	 */
 	case models.TMScan:
 		return queryTMScan(qm, client)
+	case models.TSCardinality:
+		return queryTsCardinality(qm, client)
 
 	/**
 	 * Redis Gears
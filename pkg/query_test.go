@@ -27,6 +27,8 @@ func TestQuery(t *testing.T) {
 		{queryModel{Command: models.TimeSeriesQueryIndex}},
 		{queryModel{Command: models.TimeSeriesRange}},
 		{queryModel{Command: models.TimeSeriesMRange}},
+		{queryModel{Command: models.TimeSeriesRevRange}},
+		{queryModel{Command: models.TimeSeriesMRevRange}},
 		{queryModel{Command: models.HGetAll}},
 		{queryModel{Command: models.SMembers}},
 		{queryModel{Command: models.HKeys}},
@@ -39,10 +41,28 @@ func TestQuery(t *testing.T) {
 		{queryModel{Command: models.XInfoStream}},
 		{queryModel{Command: models.ClusterInfo}},
 		{queryModel{Command: models.ClusterNodes}},
+		{queryModel{Command: models.ClusterGraph}},
+		{queryModel{Command: models.ClusterShards}},
+		{queryModel{Command: models.ClusterSlots}},
+		{queryModel{Command: models.ConfigGet}},
+		{queryModel{Command: models.ACLLog}},
+		{queryModel{Command: models.ACLList}},
+		{queryModel{Command: models.SentinelMasters}},
+		{queryModel{Command: models.SentinelReplicas, Key: "mymaster"}},
+		{queryModel{Command: models.SentinelSentinels, Key: "mymaster"}},
+		{queryModel{Command: models.SentinelCKQuorum, Key: "mymaster"}},
 		{queryModel{Command: models.SearchInfo}},
 		{queryModel{Command: models.Search}},
+		{queryModel{Command: models.SearchAggregate}},
+		{queryModel{Command: models.SearchExplain}},
+		{queryModel{Command: models.SearchProfile}},
+		{queryModel{Command: models.SearchList}},
+		{queryModel{Command: models.SearchTagVals}},
+		{queryModel{Command: models.SearchSugGet}},
+		{queryModel{Command: models.SearchSpellCheck}},
 		{queryModel{Command: models.XInfoStream}},
 		{queryModel{Command: models.TMScan}},
+		{queryModel{Command: models.TSCardinality, Filter: "type=cpu"}},
 		{queryModel{Command: models.GearsPyStats}},
 		{queryModel{Command: models.GearsDumpRegistrations}},
 		{queryModel{Command: models.GearsPyExecute}},
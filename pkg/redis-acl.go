@@ -0,0 +1,194 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/backend/log"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+)
+
+/**
+ * ACL LOG [count]
+ *
+ * @see https://redis.io/commands/acl-log
+ */
+func queryACLLog(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Execute command
+	var result []interface{}
+	var err error
+
+	if qm.Count > 0 {
+		err = client.RunCmd(&result, "ACL", "LOG", strconv.Itoa(qm.Count))
+	} else {
+		err = client.RunCmd(&result, "ACL", "LOG")
+	}
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// New Frame
+	frame := data.NewFrame(qm.Command,
+		data.NewField("Time", nil, []time.Time{}),
+		data.NewField("Reason", nil, []string{}),
+		data.NewField("Context", nil, []string{}),
+		data.NewField("Object", nil, []string{}),
+		data.NewField("Username", nil, []string{}),
+		data.NewField("Client", nil, []string{}),
+		data.NewField("Count", nil, []int64{}))
+
+	// Parse entries
+	now := time.Now()
+	for _, entry := range result {
+		values, ok := entry.([]interface{})
+		if !ok {
+			log.DefaultLogger.Error(models.ACLLog, "Conversion Error", "Unsupported Entry type")
+			continue
+		}
+
+		fields := map[string]string{}
+		var count int64
+		var created int64
+
+		// Parse fields
+		for i := 0; i+1 < len(values); i += 2 {
+			key, ok := values[i].([]byte)
+			if !ok {
+				continue
+			}
+
+			switch value := values[i+1].(type) {
+			case []byte:
+				fields[string(key)] = string(value)
+			case string:
+				fields[string(key)] = value
+			case int64:
+				switch string(key) {
+				case "count":
+					count = value
+				case "timestamp-created":
+					created = value
+				}
+			}
+		}
+
+		/**
+		 * Redis before 7.2 returns only the age of the entry in seconds
+		 */
+		ts := time.Unix(0, created*int64(time.Millisecond))
+		if created == 0 {
+			age, _ := strconv.ParseFloat(fields["age-seconds"], 64)
+			ts = now.Add(-time.Duration(age * float64(time.Second)))
+		}
+
+		frame.AppendRow(ts, fields["reason"], fields["context"], fields["object"], fields["username"], fields["client-info"], count)
+	}
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, frame)
+
+	// Return
+	return response
+}
+
+/**
+ * ACL LIST
+ *
+ * Summarizes rules for each user.
+ * @see https://redis.io/commands/acl-list
+ * @see https://redis.io/docs/management/security/acl/#acl-rules
+ */
+func queryACLList(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Execute command
+	var result []string
+	err := client.RunCmd(&result, "ACL", "LIST")
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// New Frame
+	frame := data.NewFrame(qm.Command,
+		data.NewField("User", nil, []string{}),
+		data.NewField("Enabled", nil, []bool{}),
+		data.NewField("Passwords", nil, []int64{}),
+		data.NewField("Keys", nil, []string{}),
+		data.NewField("Channels", nil, []string{}),
+		data.NewField("Categories", nil, []string{}),
+		data.NewField("Commands", nil, []string{}),
+		data.NewField("Selectors", nil, []string{}))
+
+	// Parse users
+	for _, line := range result {
+		rules := strings.Fields(line)
+
+		// Each line starts with user <username>
+		if len(rules) < 2 || rules[0] != "user" {
+			continue
+		}
+
+		enabled := false
+		var passwords int64
+		var keys, channels, categories, commands, selectors []string
+
+		for i := 2; i < len(rules); i++ {
+			rule := rules[i]
+
+			// Selectors are enclosed in parentheses and may contain spaces
+			if strings.HasPrefix(rule, "(") {
+				selector := rule
+				for !strings.HasSuffix(selector, ")") && i+1 < len(rules) {
+					i++
+					selector += " " + rules[i]
+				}
+
+				selectors = append(selectors, selector)
+				continue
+			}
+
+			switch {
+			case rule == "on":
+				enabled = true
+			case rule == "off":
+				enabled = false
+			case strings.HasPrefix(rule, "#"), strings.HasPrefix(rule, ">"):
+				passwords++
+			case rule == "allkeys":
+				keys = append(keys, "~*")
+			case strings.HasPrefix(rule, "~"), strings.HasPrefix(rule, "%"):
+				keys = append(keys, rule)
+			case rule == "allchannels":
+				channels = append(channels, "&*")
+			case strings.HasPrefix(rule, "&"):
+				channels = append(channels, rule)
+			case rule == "allcommands":
+				categories = append(categories, "+@all")
+			case rule == "nocommands":
+				categories = append(categories, "-@all")
+			case strings.HasPrefix(rule, "+@"), strings.HasPrefix(rule, "-@"):
+				categories = append(categories, rule)
+			case strings.HasPrefix(rule, "+"), strings.HasPrefix(rule, "-"):
+				commands = append(commands, rule)
+			}
+		}
+
+		frame.AppendRow(rules[1], enabled, passwords, strings.Join(keys, " "), strings.Join(channels, " "),
+			strings.Join(categories, " "), strings.Join(commands, " "), strings.Join(selectors, " "))
+	}
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, frame)
+
+	// Return
+	return response
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * ACL LOG
+ */
+func TestQueryACLLog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                    string
+		qm                      queryModel
+		rcv                     interface{}
+		fieldsCount             int
+		rowsPerField            int
+		valuesToCheckInResponse []valueToCheckInResponse
+		err                     error
+	}{
+		{
+			"should parse ACL log entries",
+			queryModel{Command: models.ACLLog, Count: 2},
+			[]interface{}{
+				[]interface{}{
+					[]byte("count"), int64(3),
+					[]byte("reason"), []byte("auth"),
+					[]byte("context"), []byte("toplevel"),
+					[]byte("object"), []byte("AUTH"),
+					[]byte("username"), []byte("someuser"),
+					[]byte("age-seconds"), []byte("8.038"),
+					[]byte("client-info"), []byte("id=3 addr=127.0.0.1:57275 laddr=127.0.0.1:6379 fd=8 name= age=16 idle=0"),
+					[]byte("entry-id"), int64(1),
+					[]byte("timestamp-created"), int64(1675361492408),
+					[]byte("timestamp-last-updated"), int64(1675361492408),
+				},
+				[]interface{}{
+					[]byte("count"), int64(1),
+					[]byte("reason"), []byte("command"),
+					[]byte("context"), []byte("multi"),
+					[]byte("object"), []byte("get"),
+					[]byte("username"), []byte("antirez"),
+					[]byte("age-seconds"), []byte("5.5"),
+					[]byte("client-info"), []byte("id=3 addr=127.0.0.1:43136 fd=8 name= age=35 idle=0"),
+				},
+			},
+			7,
+			2,
+			[]valueToCheckInResponse{
+				{frameIndex: 0, fieldIndex: 0, rowIndex: 0, value: time.Unix(0, 1675361492408*int64(time.Millisecond))},
+				{frameIndex: 0, fieldIndex: 1, rowIndex: 0, value: "auth"},
+				{frameIndex: 0, fieldIndex: 2, rowIndex: 0, value: "toplevel"},
+				{frameIndex: 0, fieldIndex: 3, rowIndex: 0, value: "AUTH"},
+				{frameIndex: 0, fieldIndex: 4, rowIndex: 0, value: "someuser"},
+				{frameIndex: 0, fieldIndex: 6, rowIndex: 0, value: int64(3)},
+				{frameIndex: 0, fieldIndex: 1, rowIndex: 1, value: "command"},
+				{frameIndex: 0, fieldIndex: 4, rowIndex: 1, value: "antirez"},
+				{frameIndex: 0, fieldIndex: 6, rowIndex: 1, value: int64(1)},
+			},
+			nil,
+		},
+		{
+			"should handle error",
+			queryModel{Command: models.ACLLog},
+			nil,
+			0,
+			0,
+			nil,
+			errors.New("error occurred"),
+		},
+	}
+
+	// Run Tests
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Client
+			client := testClient{rcv: tt.rcv, err: tt.err}
+
+			// Response
+			response := queryACLLog(tt.qm, &client)
+			if tt.err != nil {
+				require.EqualError(t, response.Error, tt.err.Error(), "Should set error to response if failed")
+				require.Nil(t, response.Frames, "No frames should be created if failed")
+			} else {
+				require.Equal(t, tt.qm.Command, response.Frames[0].Name, "Invalid frame name")
+				require.Len(t, response.Frames[0].Fields, tt.fieldsCount, "Invalid number of fields created ")
+				require.Equal(t, tt.rowsPerField, response.Frames[0].Fields[0].Len(), "Invalid number of values in field vectors")
+
+				if tt.valuesToCheckInResponse != nil {
+					for _, value := range tt.valuesToCheckInResponse {
+						require.Equalf(t, value.value, response.Frames[value.frameIndex].Fields[value.fieldIndex].At(value.rowIndex), "Invalid value at Frame[%v]:Field[%v]:Row[%v]", value.frameIndex, value.fieldIndex, value.rowIndex)
+					}
+				}
+			}
+		})
+	}
+
+	// Count
+	t.Run("should pass count as argument", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{rcv: []interface{}{}, expectedCmd: "ACL", expectedArgs: []string{"LOG", "10"}}
+
+		// Response
+		response := queryACLLog(queryModel{Command: models.ACLLog, Count: 10}, &client)
+		require.NoError(t, response.Error)
+		require.Equal(t, []string{"ACL LOG 10"}, client.calls)
+	})
+
+	// Age of the entry
+	t.Run("should calculate time from age for Redis before 7.2", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{rcv: []interface{}{
+			[]interface{}{[]byte("count"), int64(1), []byte("age-seconds"), []byte("60")},
+		}}
+
+		// Response
+		response := queryACLLog(queryModel{Command: models.ACLLog}, &client)
+		ts := response.Frames[0].Fields[0].At(0).(time.Time)
+		require.WithinDuration(t, time.Now().Add(-time.Minute), ts, 5*time.Second)
+	})
+}
+
+/**
+ * ACL LIST
+ */
+func TestQueryACLList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                    string
+		qm                      queryModel
+		rcv                     interface{}
+		fieldsCount             int
+		rowsPerField            int
+		valuesToCheckInResponse []valueToCheckInResponse
+		err                     error
+	}{
+		{
+			"should summarize ACL rules for each user",
+			queryModel{Command: models.ACLList},
+			[]string{
+				"user antirez on #9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 ~objects:* &* +@all -debug",
+				"user default on nopass ~* &* +@all",
+				"user reader off resetchannels -@all +@read +@connection %R~cache:* (~session:* +get)",
+			},
+			8,
+			3,
+			[]valueToCheckInResponse{
+				{frameIndex: 0, fieldIndex: 0, rowIndex: 0, value: "antirez"},
+				{frameIndex: 0, fieldIndex: 1, rowIndex: 0, value: true},
+				{frameIndex: 0, fieldIndex: 2, rowIndex: 0, value: int64(1)},
+				{frameIndex: 0, fieldIndex: 3, rowIndex: 0, value: "~objects:*"},
+				{frameIndex: 0, fieldIndex: 4, rowIndex: 0, value: "&*"},
+				{frameIndex: 0, fieldIndex: 5, rowIndex: 0, value: "+@all"},
+				{frameIndex: 0, fieldIndex: 6, rowIndex: 0, value: "-debug"},
+				{frameIndex: 0, fieldIndex: 2, rowIndex: 1, value: int64(0)},
+				{frameIndex: 0, fieldIndex: 1, rowIndex: 2, value: false},
+				{frameIndex: 0, fieldIndex: 3, rowIndex: 2, value: "%R~cache:*"},
+				{frameIndex: 0, fieldIndex: 5, rowIndex: 2, value: "-@all +@read +@connection"},
+				{frameIndex: 0, fieldIndex: 7, rowIndex: 2, value: "(~session:* +get)"},
+			},
+			nil,
+		},
+		{
+			"should handle error",
+			queryModel{Command: models.ACLList},
+			nil,
+			0,
+			0,
+			nil,
+			errors.New("error occurred"),
+		},
+	}
+
+	// Run Tests
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Client
+			client := testClient{rcv: tt.rcv, err: tt.err}
+
+			// Response
+			response := queryACLList(tt.qm, &client)
+			if tt.err != nil {
+				require.EqualError(t, response.Error, tt.err.Error(), "Should set error to response if failed")
+				require.Nil(t, response.Frames, "No frames should be created if failed")
+			} else {
+				require.Equal(t, tt.qm.Command, response.Frames[0].Name, "Invalid frame name")
+				require.Len(t, response.Frames[0].Fields, tt.fieldsCount, "Invalid number of fields created ")
+				require.Equal(t, tt.rowsPerField, response.Frames[0].Fields[0].Len(), "Invalid number of values in field vectors")
+
+				if tt.valuesToCheckInResponse != nil {
+					for _, value := range tt.valuesToCheckInResponse {
+						require.Equalf(t, value.value, response.Frames[value.frameIndex].Fields[value.fieldIndex].At(value.rowIndex), "Invalid value at Frame[%v]:Field[%v]:Row[%v]", value.frameIndex, value.fieldIndex, value.rowIndex)
+					}
+				}
+			}
+		})
+	}
+}
@@ -3,11 +3,13 @@ package main
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"strings"
 	"time"
 
 	"github.com/grafana/grafana-plugin-sdk-go/backend/log"
 	"github.com/mediocregopher/radix/v3"
+	"github.com/mediocregopher/radix/v3/resp/resp2"
 )
 
 /**
@@ -41,6 +43,8 @@ type redisClient interface {
 	RunFlatCmd(rcv interface{}, cmd, key string, args ...interface{}) error
 	RunCmd(rcv interface{}, cmd string, args ...string) error
 	RunBatchFlatCmd(commands []flatCommandArgs) error
+	RunNodesCmd(cmd string, args ...string) []nodeCommandResult
+	RunSentinelCmd(rcv interface{}, cmd string, args ...string) error
 	Close() error
 }
 
@@ -51,6 +55,13 @@ type flatCommandArgs struct {
 	args []interface{}
 }
 
+// nodeCommandResult is a reply of the command executed on a single node
+type nodeCommandResult struct {
+	addr string
+	rcv  interface{}
+	err  error
+}
+
 // radixClient is an interface that represents the skeleton of a connection to Redis ( cluster, standalone, or sentinel)
 type radixClient interface {
 	Do(a radix.Action) error
@@ -59,7 +70,8 @@ type radixClient interface {
 
 // radixV3Impl is an implementation of redisClient using the radix/v3 library
 type radixV3Impl struct {
-	radixClient radixClient
+	radixClient      radixClient
+	sentinelConnFunc radix.ConnFunc
 }
 
 // Execute Radix FlatCmd
@@ -84,6 +96,76 @@ func (client *radixV3Impl) RunCmd(rcv interface{}, cmd string, args ...string) e
 	return client.radixClient.Do(radix.Cmd(rcv, cmd, args...))
 }
 
+// Execute Radix Cmd on all nodes of the Cluster or Sentinel group
+func (client *radixV3Impl) RunNodesCmd(cmd string, args ...string) []nodeCommandResult {
+	var addrs []string
+	var nodeClient func(addr string) (radix.Client, error)
+
+	// Client Type
+	switch radixClient := client.radixClient.(type) {
+	case *radix.Cluster:
+		for _, node := range radixClient.Topo() {
+			addrs = append(addrs, node.Addr)
+		}
+		nodeClient = radixClient.Client
+	case *radix.Sentinel:
+		primary, secondaries := radixClient.Addrs()
+		addrs = append([]string{primary}, secondaries...)
+		nodeClient = radixClient.Client
+	default:
+		// Standalone has a single node without known address
+		result := nodeCommandResult{}
+		result.err = client.radixClient.Do(radix.Cmd(&result.rcv, cmd, args...))
+		return []nodeCommandResult{result}
+	}
+
+	// Run command on each node
+	results := make([]nodeCommandResult, 0, len(addrs))
+	for _, addr := range addrs {
+		result := nodeCommandResult{addr: addr}
+
+		if c, err := nodeClient(addr); err != nil {
+			result.err = err
+		} else {
+			result.err = c.Do(radix.Cmd(&result.rcv, cmd, args...))
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// Execute Radix Cmd on the first available Sentinel
+func (client *radixV3Impl) RunSentinelCmd(rcv interface{}, cmd string, args ...string) error {
+	sentinel, ok := client.radixClient.(*radix.Sentinel)
+	if !ok || client.sentinelConnFunc == nil {
+		return errors.New("sentinel commands require Sentinel client type")
+	}
+
+	var err error
+	for _, addr := range sentinel.SentinelAddrs() {
+		var conn radix.Conn
+		conn, err = client.sentinelConnFunc("tcp", addr)
+
+		// Try next Sentinel if not available
+		if err != nil {
+			continue
+		}
+
+		err = conn.Do(radix.Cmd(rcv, cmd, args...))
+		conn.Close()
+
+		// Return result or error replied by Sentinel
+		var redisErr resp2.Error
+		if err == nil || errors.As(err, &redisErr) {
+			return err
+		}
+	}
+
+	return err
+}
+
 // Close connection
 func (client *radixV3Impl) Close() error {
 	return client.radixClient.Close()
@@ -159,13 +241,16 @@ func newRadixV3Client(configuration redisClientConfiguration) (redisClient, erro
 			radix.PoolPipelineWindow(time.Duration(configuration.PipelineWindow)*time.Microsecond, 0))
 	}
 
+	// Sentinel connection
+	var sentinelConnFunc radix.ConnFunc
+
 	// Client Type
 	switch configuration.Client {
 	case "cluster":
 		radixClient, err = radix.NewCluster(strings.Split(configuration.URL, ","), radix.ClusterPoolFunc(poolFunc))
 	case "sentinel":
 		// Set up Sentinel connection
-		sentinelConnFunc := func(network, addr string) (radix.Conn, error) {
+		sentinelConnFunc = func(network, addr string) (radix.Conn, error) {
 			opts, err := getConnOpts(configuration)
 
 			// Return if certificate failed
@@ -196,6 +281,6 @@ func newRadixV3Client(configuration redisClientConfiguration) (redisClient, erro
 	}
 
 	// Return Radix client
-	var client = &radixV3Impl{radixClient}
+	var client = &radixV3Impl{radixClient: radixClient, sentinelConnFunc: sentinelConnFunc}
 	return client, nil
 }
@@ -18,7 +18,7 @@ func TestRadixV3Impl(t *testing.T) {
 		t.Parallel()
 
 		// Client
-		client := radixV3Impl{radix.Stub("tcp", "127.0.0.1:6379", func(args []string) interface{} {
+		client := radixV3Impl{radixClient: radix.Stub("tcp", "127.0.0.1:6379", func(args []string) interface{} {
 			return args
 		})}
 
@@ -36,7 +36,7 @@ func TestRadixV3Impl(t *testing.T) {
 		t.Parallel()
 
 		// Client
-		client := radixV3Impl{radix.Stub("tcp", "127.0.0.1:6379", func(args []string) interface{} {
+		client := radixV3Impl{radixClient: radix.Stub("tcp", "127.0.0.1:6379", func(args []string) interface{} {
 			return args
 		})}
 		var result []string
@@ -52,7 +52,7 @@ func TestRadixV3Impl(t *testing.T) {
 		t.Parallel()
 
 		// Client
-		client := radixV3Impl{radix.Stub("tcp", "127.0.0.1:6379", func(args []string) interface{} {
+		client := radixV3Impl{radixClient: radix.Stub("tcp", "127.0.0.1:6379", func(args []string) interface{} {
 			return args
 		})}
 		var result []string
@@ -68,12 +68,44 @@ func TestRadixV3Impl(t *testing.T) {
 		require.Equal(t, []string{"Command2", "SomeKey", "Arg1", "Arg2"}, result)
 	})
 
+	// Nodes
+	t.Run("should run Cmd on standalone node", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := radixV3Impl{radixClient: radix.Stub("tcp", "127.0.0.1:6379", func(args []string) interface{} {
+			return args
+		})}
+
+		// Check for Errors
+		results := client.RunNodesCmd("Command3", "Arg1")
+		require.Len(t, results, 1)
+		require.NoError(t, results[0].err)
+		require.Equal(t, "", results[0].addr)
+		require.Equal(t, []interface{}{[]byte("Command3"), []byte("Arg1")}, results[0].rcv)
+	})
+
+	// Sentinel
+	t.Run("should require Sentinel client for Sentinel command", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := radixV3Impl{radixClient: radix.Stub("tcp", "127.0.0.1:6379", func(args []string) interface{} {
+			return args
+		})}
+		var result []string
+
+		// Check for Errors
+		err := client.RunSentinelCmd(&result, "SENTINEL", "MASTERS")
+		require.EqualError(t, err, "sentinel commands require Sentinel client type")
+	})
+
 	// Close
 	t.Run("should have close method", func(t *testing.T) {
 		t.Parallel()
 
 		// Client
-		client := radixV3Impl{radix.Stub("tcp", "127.0.0.1:6379", func(args []string) interface{} {
+		client := radixV3Impl{radixClient: radix.Stub("tcp", "127.0.0.1:6379", func(args []string) interface{} {
 			return args
 		})}
 
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/backend/log"
 	"github.com/grafana/grafana-plugin-sdk-go/data"
 )
 
@@ -72,9 +75,6 @@ func queryClusterNodes(qm queryModel, client redisClient) backend.DataResponse {
 		return errorHandler(response, err)
 	}
 
-	// Split lines
-	lines := strings.Split(strings.Replace(result, "\r\n", "\n", -1), "\n")
-
 	// New Frame
 	frame := data.NewFrame(qm.Command,
 		data.NewField("Id", nil, []string{}),
@@ -91,6 +91,62 @@ func queryClusterNodes(qm queryModel, client redisClient) backend.DataResponse {
 	frame.Fields[4].Config = &data.FieldConfig{Unit: "ms"}
 	frame.Fields[5].Config = &data.FieldConfig{Unit: "ms"}
 
+	// Parse nodes
+	for _, node := range parseClusterNodes(result) {
+		slot := ""
+
+		// Check Ping and convert 0
+		if node.ping == 0 {
+			node.ping = time.Now().UnixNano() / 1e6
+		}
+
+		// Check Pong and convert 0
+		if node.pong == 0 {
+			node.pong = time.Now().UnixNano() / 1e6
+		}
+
+		// Add slots which is missing for slaves
+		if len(node.slots) > 0 {
+			slot = node.slots[0]
+		}
+
+		// Add Query
+		frame.AppendRow(node.id, node.addr, node.flags, node.master, node.ping, node.pong, node.epoch, node.linkState, slot)
+	}
+
+	// Add the frames to the response
+	response.Frames = append(response.Frames, frame)
+
+	// Return
+	return response
+}
+
+/**
+ * CLUSTER NODES entry
+ *
+ * @see https://redis.io/commands/cluster-nodes/#serialization-format
+ */
+type clusterNode struct {
+	id        string
+	addr      string
+	flags     string
+	master    string
+	ping      int64
+	pong      int64
+	epoch     int64
+	linkState string
+	slots     []string
+}
+
+/**
+ * Parse CLUSTER NODES bulk string
+ */
+func parseClusterNodes(result string) []clusterNode {
+	var nodes []clusterNode
+
+	// Split lines
+	lines := strings.Split(strings.Replace(result, "\r\n", "\n", -1), "\n")
+
 	// Parse lines
 	for _, line := range lines {
 		fields := strings.Split(line, " ")
@@ -100,38 +156,548 @@ func queryClusterNodes(qm queryModel, client redisClient) backend.DataResponse {
 			continue
 		}
 
-		var ping int64
-		var pong int64
-		var epoch int64
-		slot := ""
+		node := clusterNode{
+			id:        fields[0],
+			addr:      fields[1],
+			flags:     fields[2],
+			master:    fields[3],
+			linkState: fields[7],
+			slots:     fields[8:],
+		}
 
 		// Parse values
-		ping, _ = strconv.ParseInt(fields[4], 10, 64)
-		pong, _ = strconv.ParseInt(fields[5], 10, 64)
-		epoch, _ = strconv.ParseInt(fields[6], 10, 64)
+		node.ping, _ = strconv.ParseInt(fields[4], 10, 64)
+		node.pong, _ = strconv.ParseInt(fields[5], 10, 64)
+		node.epoch, _ = strconv.ParseInt(fields[6], 10, 64)
 
-		// Check Ping and convert 0
-		if ping == 0 {
-			ping = time.Now().UnixNano() / 1e6
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+/**
+ * Number of hash slots in the Cluster
+ */
+const clusterSlotsCount = 16384
+
+/**
+ * Cluster slot range served by the primary and replicas
+ */
+type clusterSlotRange struct {
+	start    int64
+	end      int64
+	primary  string
+	replicas []string
+	health   string
+}
+
+/**
+ * Migrating or importing slot
+ */
+type clusterSlotTransition struct {
+	slot  int64
+	state string
+	node  string
+	peer  string
+}
+
+/**
+ * CLUSTER SLOTS
+ *
+ * @see https://redis.io/commands/cluster-slots
+ */
+func queryClusterSlots(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Execute command
+	var result []interface{}
+	err := client.RunCmd(&result, "CLUSTER", "SLOTS")
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// Parse slot ranges
+	var ranges []clusterSlotRange
+	for _, entry := range result {
+		values, ok := entry.([]interface{})
+		if !ok || len(values) < 3 {
+			continue
 		}
 
-		// Check Pong and convert 0
-		if pong == 0 {
-			pong = time.Now().UnixNano() / 1e6
+		slotRange := clusterSlotRange{}
+		slotRange.start, _ = values[0].(int64)
+		slotRange.end, _ = values[1].(int64)
+		slotRange.primary = parseClusterSlotsNode(values[2])
+
+		for _, replica := range values[3:] {
+			slotRange.replicas = append(slotRange.replicas, parseClusterSlotsNode(replica))
 		}
 
-		// Add slots which is missing for slaves
-		if len(fields) > 8 {
-			slot = fields[8]
+		ranges = append(ranges, slotRange)
+	}
+
+	// Add the frames to the response
+	response.Frames = append(response.Frames, createClusterSlotsFrames(qm, client, ranges)...)
+
+	// Return
+	return response
+}
+
+/**
+ * CLUSTER SHARDS
+ *
+ * Available since Redis 7.0.0
+ * @see https://redis.io/commands/cluster-shards
+ */
+func queryClusterShards(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Execute command
+	var result []interface{}
+	err := client.RunCmd(&result, "CLUSTER", "SHARDS")
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// Parse shards
+	var ranges []clusterSlotRange
+	for _, entry := range result {
+		values, ok := entry.([]interface{})
+		if !ok {
+			continue
 		}
 
-		// Add Query
-		frame.AppendRow(fields[0], fields[1], fields[2], fields[3], ping, pong, epoch, fields[7], slot)
+		shard := parseMapReply(values)
+		slots, _ := shard["slots"].([]interface{})
+		nodes, _ := shard["nodes"].([]interface{})
+
+		// Primary and replicas
+		var primary, health string
+		var replicas []string
+
+		for _, node := range nodes {
+			nodeValues, ok := node.([]interface{})
+			if !ok {
+				continue
+			}
+
+			nodeMap := parseMapReply(nodeValues)
+			addr := clusterShardNodeAddr(nodeMap)
+			nodeHealth := replyToString(nodeMap["health"])
+
+			if replyToString(nodeMap["role"]) == "master" {
+				primary = addr
+				health = nodeHealth
+				continue
+			}
+
+			// Add replica health if not online
+			if nodeHealth != "online" {
+				addr = fmt.Sprintf("%s (%s)", addr, nodeHealth)
+			}
+
+			replicas = append(replicas, addr)
+		}
+
+		// Shard can serve multiple slot ranges
+		for i := 0; i+1 < len(slots); i += 2 {
+			slotRange := clusterSlotRange{primary: primary, replicas: replicas, health: health}
+			slotRange.start, _ = slots[i].(int64)
+			slotRange.end, _ = slots[i+1].(int64)
+
+			ranges = append(ranges, slotRange)
+		}
 	}
 
 	// Add the frames to the response
-	response.Frames = append(response.Frames, frame)
+	response.Frames = append(response.Frames, createClusterSlotsFrames(qm, client, ranges)...)
 
 	// Return
 	return response
 }
+
+/**
+ * Create frames with slot ranges, coverage summary and migrating or importing slots
+ */
+func createClusterSlotsFrames(qm queryModel, client redisClient, ranges []clusterSlotRange) []*data.Frame {
+	// Nodes and transitions reported by each node
+	nodes, transitions := queryClusterNodesState(client)
+
+	// Sort by start slot
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].start < ranges[j].start
+	})
+
+	// New Frame for slot ranges
+	frame := data.NewFrame(qm.Command,
+		data.NewField("Start", nil, []int64{}),
+		data.NewField("End", nil, []int64{}),
+		data.NewField("Slots", nil, []int64{}),
+		data.NewField("Primary", nil, []string{}),
+		data.NewField("Replicas", nil, []string{}),
+		data.NewField("Health", nil, []string{}))
+
+	// Assigned slots
+	var assigned [clusterSlotsCount]bool
+
+	for _, slotRange := range ranges {
+		// Health is not reported by CLUSTER SLOTS
+		health := slotRange.health
+		if health == "" {
+			health = clusterNodeHealth(nodes[slotRange.primary])
+		}
+
+		for slot := slotRange.start; slot <= slotRange.end && slot < clusterSlotsCount; slot++ {
+			assigned[slot] = true
+		}
+
+		frame.AppendRow(slotRange.start, slotRange.end, slotRange.end-slotRange.start+1, slotRange.primary,
+			strings.Join(slotRange.replicas, ", "), health)
+	}
+
+	// Unassigned slots and ranges
+	var assignedCount int64
+	var unassigned []string
+
+	for slot := 0; slot < clusterSlotsCount; slot++ {
+		if assigned[slot] {
+			assignedCount++
+			continue
+		}
+
+		// Find the end of unassigned range
+		end := slot
+		for end+1 < clusterSlotsCount && !assigned[end+1] {
+			end++
+		}
+
+		if end == slot {
+			unassigned = append(unassigned, strconv.Itoa(slot))
+		} else {
+			unassigned = append(unassigned, fmt.Sprintf("%d-%d", slot, end))
+		}
+
+		slot = end
+	}
+
+	// Migrating and importing slots
+	var migrating, importing int64
+	for _, transition := range transitions {
+		if transition.state == "migrating" {
+			migrating++
+		} else {
+			importing++
+		}
+	}
+
+	// New Frame for coverage
+	frameCoverage := data.NewFrame("coverage",
+		data.NewField("Assigned", nil, []int64{assignedCount}),
+		data.NewField("Unassigned", nil, []int64{clusterSlotsCount - assignedCount}),
+		data.NewField("Migrating", nil, []int64{migrating}),
+		data.NewField("Importing", nil, []int64{importing}),
+		data.NewField("Coverage", nil, []float64{float64(assignedCount) / clusterSlotsCount}).SetConfig(&data.FieldConfig{Unit: "percentunit"}),
+		data.NewField("Unassigned Ranges", nil, []string{strings.Join(unassigned, ", ")}))
+
+	frames := []*data.Frame{frame, frameCoverage}
+
+	// Add Frame with migrating and importing slots if found
+	if len(transitions) > 0 {
+		frameTransitions := data.NewFrame("transitions",
+			data.NewField("Slot", nil, []int64{}),
+			data.NewField("State", nil, []string{}),
+			data.NewField("Node", nil, []string{}),
+			data.NewField("Peer", nil, []string{}))
+
+		for _, transition := range transitions {
+			peer := transition.peer
+
+			// Use address of the peer if known
+			for addr, node := range nodes {
+				if node.id == transition.peer {
+					peer = addr
+					break
+				}
+			}
+
+			frameTransitions.AppendRow(transition.slot, transition.state, transition.node, peer)
+		}
+
+		frames = append(frames, frameTransitions)
+	}
+
+	return frames
+}
+
+/**
+ * Run CLUSTER NODES on all nodes to find node states and slots which are migrating or importing
+ *
+ * Migrating and importing slots are reported only by the node itself.
+ */
+func queryClusterNodesState(client redisClient) (map[string]clusterNode, []clusterSlotTransition) {
+	nodes := map[string]clusterNode{}
+	var transitions []clusterSlotTransition
+
+	for _, result := range client.RunNodesCmd("CLUSTER", "NODES") {
+		if result.err != nil {
+			log.DefaultLogger.Error("CLUSTER NODES", "Node", result.addr, "Error", result.err)
+			continue
+		}
+
+		for _, node := range parseClusterNodes(replyToString(result.rcv)) {
+			addr := clusterNodeAddr(node.addr)
+
+			// Failures are reported by other nodes
+			if _, ok := nodes[addr]; !ok || strings.Contains(node.flags, "fail") {
+				nodes[addr] = node
+			}
+
+			// Transitions are reported only for the node itself
+			if !strings.Contains(node.flags, "myself") {
+				continue
+			}
+
+			for _, slot := range node.slots {
+				// Format is [slot->-node] for migrating and [slot-<-node] for importing
+				if !strings.HasPrefix(slot, "[") {
+					continue
+				}
+
+				transition := clusterSlotTransition{node: addr}
+				values := strings.Trim(slot, "[]")
+
+				if fields := strings.SplitN(values, "->-", 2); len(fields) == 2 {
+					transition.state = "migrating"
+					transition.slot, _ = strconv.ParseInt(fields[0], 10, 64)
+					transition.peer = fields[1]
+				} else if fields := strings.SplitN(values, "-<-", 2); len(fields) == 2 {
+					transition.state = "importing"
+					transition.slot, _ = strconv.ParseInt(fields[0], 10, 64)
+					transition.peer = fields[1]
+				} else {
+					continue
+				}
+
+				transitions = append(transitions, transition)
+			}
+		}
+	}
+
+	// Sort by slot
+	sort.Slice(transitions, func(i, j int) bool {
+		return transitions[i].slot < transitions[j].slot
+	})
+
+	return nodes, transitions
+}
+
+/**
+ * Parse node address from CLUSTER SLOTS reply
+ */
+func parseClusterSlotsNode(reply interface{}) string {
+	values, ok := reply.([]interface{})
+	if !ok || len(values) < 2 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%v", replyToString(values[0]), values[1])
+}
+
+/**
+ * Parse node address from CLUSTER SHARDS reply
+ *
+ * TLS-only nodes report tls-port instead of port, ip can be missing when nodes are announced by hostname.
+ */
+func clusterShardNodeAddr(node map[string]interface{}) string {
+	host := replyToString(node["ip"])
+	for _, name := range []string{"endpoint", "hostname"} {
+		if host != "" {
+			break
+		}
+
+		host = replyToString(node[name])
+	}
+
+	port := replyToString(node["port"])
+	if port == "" {
+		port = replyToString(node["tls-port"])
+	}
+
+	return fmt.Sprintf("%s:%s", host, port)
+}
+
+/**
+ * Return node address without cluster bus port and hostname
+ */
+func clusterNodeAddr(addr string) string {
+	return strings.SplitN(strings.SplitN(addr, ",", 2)[0], "@", 2)[0]
+}
+
+/**
+ * Return node health based on flags and link state
+ */
+func clusterNodeHealth(node clusterNode) string {
+	switch {
+	case node.id == "":
+		return "unknown"
+	case strings.Contains(node.flags, "fail?"):
+		return "pfail"
+	case strings.Contains(node.flags, "fail"):
+		return "failed"
+	case strings.Contains(node.flags, "handshake"):
+		return "handshake"
+	case strings.Contains(node.flags, "noaddr"):
+		return "noaddr"
+	case node.linkState != "connected":
+		return node.linkState
+	default:
+		return "online"
+	}
+}
+
+/**
+ * Node health colors for node graph arcs
+ */
+var clusterNodeHealthColors = []struct {
+	health string
+	color  string
+}{
+	{"online", "green"},
+	{"pfail", "yellow"},
+	{"failed", "red"},
+	{"disconnected", "orange"},
+	{"unknown", "gray"},
+}
+
+/**
+ * Return node health arc, states without a color such as handshake, noaddr or loading are unknown
+ */
+func clusterNodeHealthArc(health string) string {
+	for _, state := range clusterNodeHealthColors {
+		if state.health == health {
+			return health
+		}
+	}
+
+	return "unknown"
+}
+
+/**
+ * CLUSTER NODES as node graph
+ *
+ * Nodes are Redis nodes with role, number of slots and link state, edges are replica-of relations.
+ * @see https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/node-graph/#data-api
+ */
+func queryClusterNodesGraph(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Execute command
+	var result string
+	err := client.RunCmd(&result, "CLUSTER", "NODES")
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// New Frame for nodes
+	frameWithNodes := data.NewFrame("nodes")
+	frameWithNodes.Meta = &data.FrameMeta{
+		PreferredVisualization: "nodeGraph",
+	}
+	frameWithNodes.Fields = append(frameWithNodes.Fields, data.NewField("id", nil, []string{}))
+	frameWithNodes.Fields = append(frameWithNodes.Fields, data.NewField("title", nil, []string{}))
+	frameWithNodes.Fields = append(frameWithNodes.Fields, data.NewField("subTitle", nil, []string{}))
+	frameWithNodes.Fields = append(frameWithNodes.Fields, data.NewField("mainStat", nil, []string{}))
+	frameWithNodes.Fields = append(frameWithNodes.Fields, data.NewField("secondaryStat", nil, []string{}))
+
+	// Arc for each health state
+	for _, state := range clusterNodeHealthColors {
+		field := data.NewField("arc__"+state.health, nil, []float64{})
+		field.Config = &data.FieldConfig{
+			DisplayName: state.health,
+			Color:       map[string]interface{}{"mode": "fixed", "fixedColor": state.color},
+		}
+		frameWithNodes.Fields = append(frameWithNodes.Fields, field)
+	}
+
+	// New Frame for edges
+	frameWithEdges := data.NewFrame("edges")
+	frameWithEdges.Meta = &data.FrameMeta{
+		PreferredVisualization: "nodeGraph",
+	}
+	frameWithEdges.Fields = append(frameWithEdges.Fields, data.NewField("id", nil, []string{}))
+	frameWithEdges.Fields = append(frameWithEdges.Fields, data.NewField("source", nil, []string{}))
+	frameWithEdges.Fields = append(frameWithEdges.Fields, data.NewField("target", nil, []string{}))
+	frameWithEdges.Fields = append(frameWithEdges.Fields, data.NewField("mainStat", nil, []string{}))
+
+	// Parse nodes
+	for _, node := range parseClusterNodes(result) {
+		health := clusterNodeHealth(node)
+
+		// Role
+		role := "replica"
+		if strings.Contains(node.flags, "master") {
+			role = "primary"
+		}
+
+		// Node values with arcs
+		values := []interface{}{node.id, clusterNodeAddr(node.addr), role,
+			fmt.Sprintf("%d slots", countClusterNodeSlots(node.slots)), health}
+		arc := clusterNodeHealthArc(health)
+		for _, state := range clusterNodeHealthColors {
+			if state.health == arc {
+				values = append(values, float64(1))
+			} else {
+				values = append(values, float64(0))
+			}
+		}
+
+		frameWithNodes.AppendRow(values...)
+
+		// Add replica-of edge
+		if node.master != "-" && node.master != "" {
+			frameWithEdges.AppendRow(node.id+"-"+node.master, node.id, node.master, "replica of")
+		}
+	}
+
+	// Add Frames with Nodes and Edges
+	response.Frames = append(response.Frames, frameWithNodes, frameWithEdges)
+
+	// Return
+	return response
+}
+
+/**
+ * Count number of slots served by the node
+ */
+func countClusterNodeSlots(slots []string) int64 {
+	var count int64
+
+	for _, slot := range slots {
+		// Skip migrating and importing slots
+		if strings.HasPrefix(slot, "[") {
+			continue
+		}
+
+		fields := strings.SplitN(slot, "-", 2)
+		start, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		end := start
+		if len(fields) == 2 {
+			end, _ = strconv.ParseInt(fields[1], 10, 64)
+		}
+
+		count += end - start + 1
+	}
+
+	return count
+}
@@ -13,7 +13,7 @@ func TestCluster(t *testing.T) {
 	radixClient, err := radix.NewCluster([]string{"redis://redis-cluster1:6379", "redis://redis-cluster2:6379", "redis://redis-cluster3:6379"})
 
 	require.Nil(t, err)
-	var client = &radixV3Impl{radixClient}
+	var client = &radixV3Impl{radixClient: radixClient}
 	var result interface{}
 
 	client.RunCmd(&result, "PING")
@@ -166,3 +166,270 @@ func TestQueryClusterNodes(t *testing.T) {
 		})
 	}
 }
+
+/**
+ * CLUSTER SLOTS
+ */
+func TestQueryClusterSlots(t *testing.T) {
+	t.Parallel()
+
+	// Slot ranges
+	t.Run("should parse slot ranges and coverage", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{
+			rcv: []interface{}{
+				[]interface{}{
+					int64(5461), int64(10922),
+					[]interface{}{[]byte("127.0.0.1"), int64(30002), []byte("67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1")},
+					[]interface{}{[]byte("127.0.0.1"), int64(30005), []byte("6ec23923021cf3ffec47632106199cb7f496ce01")},
+				},
+				[]interface{}{
+					int64(0), int64(5460),
+					[]interface{}{[]byte("127.0.0.1"), int64(30001), []byte("e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca")},
+					[]interface{}{[]byte("127.0.0.1"), int64(30004), []byte("07c37dfeb235213a872192d90877d0cd55635b91")},
+				},
+			},
+			nodesRcv: []nodeCommandResult{
+				{addr: "127.0.0.1:30001", rcv: []byte("e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected 0-5460 [93->-67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1]\r\n67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1 127.0.0.1:30002@31002 master,fail - 0 1426238316232 2 connected 5461-10922")},
+				{addr: "127.0.0.1:30002", rcv: []byte("67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1 127.0.0.1:30002@31002 myself,master - 0 0 2 connected 5461-10922 [93-<-e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca]")},
+			},
+		}
+
+		// Response
+		response := queryClusterSlots(queryModel{Command: models.ClusterSlots}, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 3)
+
+		// Slot ranges sorted by start
+		frame := response.Frames[0]
+		require.Equal(t, models.ClusterSlots, frame.Name)
+		require.Equal(t, 2, frame.Rows())
+		require.Equal(t, int64(0), frame.Fields[0].At(0))
+		require.Equal(t, int64(5460), frame.Fields[1].At(0))
+		require.Equal(t, int64(5461), frame.Fields[2].At(0))
+		require.Equal(t, "127.0.0.1:30001", frame.Fields[3].At(0))
+		require.Equal(t, "127.0.0.1:30004", frame.Fields[4].At(0))
+		require.Equal(t, "online", frame.Fields[5].At(0))
+		require.Equal(t, "failed", frame.Fields[5].At(1))
+
+		// Coverage
+		frame = response.Frames[1]
+		require.Equal(t, int64(10923), frame.Fields[0].At(0))
+		require.Equal(t, int64(5461), frame.Fields[1].At(0))
+		require.Equal(t, int64(1), frame.Fields[2].At(0))
+		require.Equal(t, int64(1), frame.Fields[3].At(0))
+		require.Equal(t, "10923-16383", frame.Fields[5].At(0))
+
+		// Migrating and importing slots
+		frame = response.Frames[2]
+		require.Equal(t, 2, frame.Rows())
+		require.Equal(t, int64(93), frame.Fields[0].At(0))
+		require.ElementsMatch(t, []interface{}{"migrating", "importing"}, []interface{}{frame.Fields[1].At(0), frame.Fields[1].At(1)})
+	})
+
+	// Error
+	t.Run("should handle error", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{err: errors.New("error occurred")}
+
+		// Response
+		response := queryClusterSlots(queryModel{Command: models.ClusterSlots}, &client)
+		require.EqualError(t, response.Error, "error occurred")
+		require.Nil(t, response.Frames)
+	})
+}
+
+/**
+ * CLUSTER SHARDS
+ */
+func TestQueryClusterShards(t *testing.T) {
+	t.Parallel()
+
+	// Shards
+	t.Run("should parse shards with health", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{
+			rcv: []interface{}{
+				[]interface{}{
+					[]byte("slots"), []interface{}{int64(0), int64(5460), int64(10923), int64(16383)},
+					[]byte("nodes"), []interface{}{
+						[]interface{}{
+							[]byte("id"), []byte("e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca"),
+							[]byte("port"), int64(30001),
+							[]byte("ip"), []byte("127.0.0.1"),
+							[]byte("role"), []byte("master"),
+							[]byte("replication-offset"), int64(72156),
+							[]byte("health"), []byte("online"),
+						},
+						[]interface{}{
+							[]byte("id"), []byte("07c37dfeb235213a872192d90877d0cd55635b91"),
+							[]byte("port"), int64(30004),
+							[]byte("ip"), []byte("127.0.0.1"),
+							[]byte("role"), []byte("replica"),
+							[]byte("replication-offset"), int64(72156),
+							[]byte("health"), []byte("loading"),
+						},
+					},
+				},
+			},
+			nodesRcv: []nodeCommandResult{},
+		}
+
+		// Response
+		response := queryClusterShards(queryModel{Command: models.ClusterShards}, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 2)
+
+		frame := response.Frames[0]
+		require.Equal(t, 2, frame.Rows())
+		require.Equal(t, int64(10923), frame.Fields[0].At(1))
+		require.Equal(t, int64(5461), frame.Fields[2].At(1))
+		require.Equal(t, "127.0.0.1:30001", frame.Fields[3].At(1))
+		require.Equal(t, "127.0.0.1:30004 (loading)", frame.Fields[4].At(1))
+		require.Equal(t, "online", frame.Fields[5].At(1))
+
+		// Coverage
+		frame = response.Frames[1]
+		require.Equal(t, int64(10922), frame.Fields[0].At(0))
+		require.Equal(t, int64(5462), frame.Fields[1].At(0))
+		require.Equal(t, "5461-10922", frame.Fields[5].At(0))
+	})
+
+	// TLS
+	t.Run("should use TLS port and hostname", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{
+			rcv: []interface{}{
+				[]interface{}{
+					[]byte("slots"), []interface{}{int64(0), int64(16383)},
+					[]byte("nodes"), []interface{}{
+						[]interface{}{
+							[]byte("id"), []byte("e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca"),
+							[]byte("tls-port"), int64(30001),
+							[]byte("hostname"), []byte("redis-1"),
+							[]byte("role"), []byte("master"),
+							[]byte("health"), []byte("online"),
+						},
+						[]interface{}{
+							[]byte("id"), []byte("07c37dfeb235213a872192d90877d0cd55635b91"),
+							[]byte("tls-port"), int64(30004),
+							[]byte("endpoint"), []byte("redis-4.example.com"),
+							[]byte("hostname"), []byte("redis-4"),
+							[]byte("role"), []byte("replica"),
+							[]byte("health"), []byte("online"),
+						},
+					},
+				},
+			},
+			nodesRcv: []nodeCommandResult{},
+		}
+
+		// Response
+		response := queryClusterShards(queryModel{Command: models.ClusterShards}, &client)
+		require.NoError(t, response.Error)
+
+		frame := response.Frames[0]
+		require.Equal(t, "redis-1:30001", frame.Fields[3].At(0))
+		require.Equal(t, "redis-4.example.com:30004", frame.Fields[4].At(0))
+	})
+
+	// Error
+	t.Run("should handle error", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{err: errors.New("error occurred")}
+
+		// Response
+		response := queryClusterShards(queryModel{Command: models.ClusterShards}, &client)
+		require.EqualError(t, response.Error, "error occurred")
+		require.Nil(t, response.Frames)
+	})
+}
+
+/**
+ * CLUSTER NODES as node graph
+ */
+func TestQueryClusterNodesGraph(t *testing.T) {
+	t.Parallel()
+
+	// Graph
+	t.Run("should create nodes and replica-of edges", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{rcv: "07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004 slave,fail e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 1609783649927 1426238317239 4 disconnected\r\n67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1 127.0.0.1:30002@31002 master - 0 1426238316232 2 connected 5461-10922 10923\r\ne7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected 0-5460 [93->-67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1]"}
+
+		// Response
+		response := queryClusterNodesGraph(queryModel{Command: models.ClusterGraph}, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 2)
+
+		// Nodes
+		nodes := response.Frames[0]
+		require.Equal(t, "nodes", nodes.Name)
+		require.Equal(t, "nodeGraph", string(nodes.Meta.PreferredVisualization))
+		require.Equal(t, 3, nodes.Rows())
+		require.Len(t, nodes.Fields, 10)
+		require.Equal(t, "127.0.0.1:30004", nodes.Fields[1].At(0))
+		require.Equal(t, "replica", nodes.Fields[2].At(0))
+		require.Equal(t, "0 slots", nodes.Fields[3].At(0))
+		require.Equal(t, "failed", nodes.Fields[4].At(0))
+		require.Equal(t, "arc__failed", nodes.Fields[7].Name)
+		require.Equal(t, float64(1), nodes.Fields[7].At(0))
+		require.Equal(t, float64(0), nodes.Fields[5].At(0))
+		require.Equal(t, "primary", nodes.Fields[2].At(1))
+		require.Equal(t, "5463 slots", nodes.Fields[3].At(1))
+		require.Equal(t, float64(1), nodes.Fields[5].At(1))
+		require.Equal(t, "5461 slots", nodes.Fields[3].At(2))
+
+		// Edges
+		edges := response.Frames[1]
+		require.Equal(t, "edges", edges.Name)
+		require.Equal(t, 1, edges.Rows())
+		require.Equal(t, "07c37dfeb235213a872192d90877d0cd55635b91", edges.Fields[1].At(0))
+		require.Equal(t, "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca", edges.Fields[2].At(0))
+	})
+
+	// Unknown health
+	t.Run("should add unknown arc for other states", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{rcv: "07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004 handshake - 0 0 0 connected\r\n67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1 :0@0 master,noaddr - 0 0 2 connected 5461-10922"}
+
+		// Response
+		response := queryClusterNodesGraph(queryModel{Command: models.ClusterGraph}, &client)
+		require.NoError(t, response.Error)
+
+		nodes := response.Frames[0]
+		require.Equal(t, "arc__unknown", nodes.Fields[9].Name)
+		for i := 0; i < nodes.Rows(); i++ {
+			require.Equal(t, float64(1), nodes.Fields[9].At(i))
+		}
+
+		require.Equal(t, "handshake", nodes.Fields[4].At(0))
+		require.Equal(t, "noaddr", nodes.Fields[4].At(1))
+	})
+
+	// Error
+	t.Run("should handle error", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{err: errors.New("error occurred")}
+
+		// Response
+		response := queryClusterNodesGraph(queryModel{Command: models.ClusterGraph}, &client)
+		require.EqualError(t, response.Error, "error occurred")
+		require.Nil(t, response.Frames)
+	})
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/backend/log"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+)
+
+/**
+ * CONFIG GET parameter
+ *
+ * @see https://redis.io/commands/config-get
+ */
+func queryConfigGet(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Parameter pattern
+	pattern := "*"
+	if qm.Match != "" {
+		pattern = qm.Match
+	}
+
+	// Compare configuration across all nodes
+	if qm.CompareNodes {
+		return queryConfigGetNodes(qm, client, pattern)
+	}
+
+	// Execute command
+	var result map[string]string
+	err := client.RunCmd(&result, "CONFIG", "GET", pattern)
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// New Frame
+	frame := data.NewFrame(qm.Command,
+		data.NewField("Parameter", nil, []string{}),
+		data.NewField("Value", nil, []string{}))
+
+	// Add sorted parameters
+	for _, parameter := range sortedConfigParameters(result) {
+		frame.AppendRow(parameter, result[parameter])
+	}
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, frame)
+
+	// Return
+	return response
+}
+
+/**
+ * CONFIG GET parameter executed on all nodes
+ *
+ * Returns value for each node and highlights parameters which values differ.
+ */
+func queryConfigGetNodes(qm queryModel, client redisClient, pattern string) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Execute command on all nodes
+	results := client.RunNodesCmd("CONFIG", "GET", pattern)
+
+	// Parse configuration for each node
+	var nodes []string
+	configs := map[string]map[string]string{}
+	parameters := map[string]string{}
+
+	for _, result := range results {
+		// Skip failed nodes
+		if result.err != nil {
+			log.DefaultLogger.Error(models.ConfigGet, "Node", result.addr, "Error", result.err)
+			continue
+		}
+
+		// Node address is unknown for Standalone
+		node := result.addr
+		if node == "" {
+			node = "Value"
+		}
+
+		nodes = append(nodes, node)
+		configs[node] = parseConfigGetReply(result.rcv)
+
+		for parameter, value := range configs[node] {
+			parameters[parameter] = value
+		}
+	}
+
+	// Check if all nodes failed
+	if len(nodes) == 0 && len(results) > 0 {
+		return errorHandler(response, results[0].err)
+	}
+
+	// New Frame
+	frame := data.NewFrame(qm.Command, data.NewField("Parameter", nil, []string{}))
+	for _, node := range nodes {
+		frame.Fields = append(frame.Fields, data.NewField(node, nil, []*string{}))
+	}
+
+	// Highlight drifted parameters
+	driftField := data.NewField("Drift", nil, []bool{})
+	driftField.Config = &data.FieldConfig{
+		Mappings: data.ValueMappings{
+			data.ValueMapper{
+				"true":  data.ValueMappingResult{Text: "Drift", Color: "red"},
+				"false": data.ValueMappingResult{Text: "OK", Color: "green"},
+			},
+		},
+	}
+	frame.Fields = append(frame.Fields, driftField)
+
+	// Add sorted parameters
+	for _, parameter := range sortedConfigParameters(parameters) {
+		values := []interface{}{parameter}
+		drift := false
+
+		for _, node := range nodes {
+			value, ok := configs[node][parameter]
+
+			// Parameter is missing on the node
+			if !ok {
+				values = append(values, nil)
+				drift = true
+				continue
+			}
+
+			if value != parameters[parameter] {
+				drift = true
+			}
+
+			values = append(values, &value)
+		}
+
+		frame.AppendRow(append(values, drift)...)
+	}
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, frame)
+
+	// Return
+	return response
+}
+
+/**
+ * Parse CONFIG GET reply with parameter and value pairs
+ */
+func parseConfigGetReply(reply interface{}) map[string]string {
+	config := map[string]string{}
+
+	values, ok := reply.([]interface{})
+	if !ok {
+		return config
+	}
+
+	for i := 0; i+1 < len(values); i += 2 {
+		parameter, ok := values[i].([]byte)
+		if !ok {
+			continue
+		}
+
+		switch value := values[i+1].(type) {
+		case []byte:
+			config[string(parameter)] = string(value)
+		case string:
+			config[string(parameter)] = value
+		}
+	}
+
+	return config
+}
+
+/**
+ * Return sorted configuration parameters
+ */
+func sortedConfigParameters(config map[string]string) []string {
+	parameters := make([]string, 0, len(config))
+	for parameter := range config {
+		parameters = append(parameters, parameter)
+	}
+
+	sort.Strings(parameters)
+	return parameters
+}
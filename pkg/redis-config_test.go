@@ -0,0 +1,152 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * CONFIG GET
+ */
+func TestQueryConfigGet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                    string
+		qm                      queryModel
+		rcv                     interface{}
+		fieldsCount             int
+		rowsPerField            int
+		valuesToCheckInResponse []valueToCheckInResponse
+		err                     error
+	}{
+		{
+			"should parse configuration sorted by parameter",
+			queryModel{Command: models.ConfigGet, Match: "max*"},
+			map[string]string{"maxmemory": "0", "maxclients": "10000", "maxmemory-policy": "noeviction"},
+			2,
+			3,
+			[]valueToCheckInResponse{
+				{frameIndex: 0, fieldIndex: 0, rowIndex: 0, value: "maxclients"},
+				{frameIndex: 0, fieldIndex: 1, rowIndex: 0, value: "10000"},
+				{frameIndex: 0, fieldIndex: 0, rowIndex: 2, value: "maxmemory-policy"},
+				{frameIndex: 0, fieldIndex: 1, rowIndex: 2, value: "noeviction"},
+			},
+			nil,
+		},
+		{
+			"should handle error",
+			queryModel{Command: models.ConfigGet},
+			nil,
+			0,
+			0,
+			nil,
+			errors.New("error occurred"),
+		},
+	}
+
+	// Run Tests
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Client
+			client := testClient{rcv: tt.rcv, err: tt.err}
+
+			// Response
+			response := queryConfigGet(tt.qm, &client)
+			if tt.err != nil {
+				require.EqualError(t, response.Error, tt.err.Error(), "Should set error to response if failed")
+				require.Nil(t, response.Frames, "No frames should be created if failed")
+			} else {
+				require.Equal(t, tt.qm.Command, response.Frames[0].Name, "Invalid frame name")
+				require.Len(t, response.Frames[0].Fields, tt.fieldsCount, "Invalid number of fields created ")
+				require.Equal(t, tt.rowsPerField, response.Frames[0].Fields[0].Len(), "Invalid number of values in field vectors")
+
+				if tt.valuesToCheckInResponse != nil {
+					for _, value := range tt.valuesToCheckInResponse {
+						require.Equalf(t, value.value, response.Frames[value.frameIndex].Fields[value.fieldIndex].At(value.rowIndex), "Invalid value at Frame[%v]:Field[%v]:Row[%v]", value.frameIndex, value.fieldIndex, value.rowIndex)
+					}
+				}
+			}
+		})
+	}
+}
+
+/**
+ * CONFIG GET on all nodes
+ */
+func TestQueryConfigGetCompareNodes(t *testing.T) {
+	t.Parallel()
+
+	// Drift
+	t.Run("should highlight parameters with different values", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{
+			nodesRcv: []nodeCommandResult{
+				{addr: "127.0.0.1:30001", rcv: []interface{}{[]byte("maxmemory"), []byte("0"), []byte("appendonly"), []byte("no")}},
+				{addr: "127.0.0.1:30002", rcv: []interface{}{[]byte("maxmemory"), []byte("1024"), []byte("appendonly"), []byte("no")}},
+				{addr: "127.0.0.1:30003", err: errors.New("connection refused")},
+			},
+		}
+
+		// Response
+		response := queryConfigGet(queryModel{Command: models.ConfigGet, CompareNodes: true}, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 1)
+
+		frame := response.Frames[0]
+		require.Len(t, frame.Fields, 4)
+		require.Equal(t, "127.0.0.1:30001", frame.Fields[1].Name)
+		require.Equal(t, "127.0.0.1:30002", frame.Fields[2].Name)
+		require.Equal(t, "Drift", frame.Fields[3].Name)
+		require.Equal(t, 2, frame.Rows())
+
+		require.Equal(t, "appendonly", frame.Fields[0].At(0))
+		require.Equal(t, false, frame.Fields[3].At(0))
+		require.Equal(t, "maxmemory", frame.Fields[0].At(1))
+		require.Equal(t, "1024", *(frame.Fields[2].At(1).(*string)))
+		require.Equal(t, true, frame.Fields[3].At(1))
+	})
+
+	// Missing parameter
+	t.Run("should mark parameter missing on the node as drift", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{
+			nodesRcv: []nodeCommandResult{
+				{addr: "127.0.0.1:30001", rcv: []interface{}{[]byte("maxmemory"), []byte("0"), []byte("io-threads"), []byte("4")}},
+				{addr: "127.0.0.1:30002", rcv: []interface{}{[]byte("maxmemory"), []byte("0")}},
+			},
+		}
+
+		// Response
+		response := queryConfigGet(queryModel{Command: models.ConfigGet, CompareNodes: true}, &client)
+		frame := response.Frames[0]
+		require.Equal(t, "io-threads", frame.Fields[0].At(0))
+		require.Nil(t, frame.Fields[2].At(0))
+		require.Equal(t, true, frame.Fields[3].At(0))
+		require.Equal(t, false, frame.Fields[3].At(1))
+	})
+
+	// Error
+	t.Run("should handle error", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{err: errors.New("error occurred")}
+
+		// Response
+		response := queryConfigGet(queryModel{Command: models.ConfigGet, CompareNodes: true}, &client)
+		require.EqualError(t, response.Error, "error occurred")
+		require.Nil(t, response.Frames)
+	})
+}
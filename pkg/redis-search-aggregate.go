@@ -0,0 +1,424 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/backend/log"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+)
+
+/**
+ * FT.AGGREGATE {index} {query} [LOAD] [GROUPBY ... REDUCE ...] [APPLY] [FILTER] [SORTBY] [LIMIT] [WITHCURSOR]
+ * FT.CURSOR READ {index} {cursor} [COUNT]
+ *
+ * With the timestamp field documents in the time range are grouped into buckets and returned as time-series.
+ * With the cursor next page is returned in the Cursor frame and read by the following query.
+ * Cursor is deleted when the read fails or the following query is sent without cursor option.
+ *
+ * @see https://redis.io/commands/ft.aggregate
+ * @see https://redis.io/commands/ft.cursor-read
+ */
+func queryFtAggregate(from int64, to int64, qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Cursor returned by the previous query
+	cursor := qm.Cursor != "" && qm.Cursor != "0"
+	if cursor {
+		if _, err := strconv.ParseInt(qm.Cursor, 10, 64); err != nil {
+			return errorHandler(response, fmt.Errorf("cursor is not valid: %s", qm.Cursor))
+		}
+
+		// Reading is stopped, delete the cursor and run aggregation without cursor
+		if !qm.WithCursor {
+			deleteFtCursor(qm, client)
+			cursor = false
+		}
+	}
+
+	// Arguments
+	var args []string
+	var err error
+	if cursor {
+		args = []string{"READ", qm.Key, qm.Cursor}
+		if qm.Count > 0 {
+			args = append(args, "COUNT", strconv.Itoa(qm.Count))
+		}
+	} else {
+		args, err = ftAggregateArgs(from, to, qm)
+		if err != nil {
+			return errorHandler(response, err)
+		}
+
+		if qm.WithCursor {
+			args = append(args, ftCursorArgs(qm)...)
+		}
+	}
+
+	// Execute command
+	var result []interface{}
+	if cursor {
+		err = client.RunCmd(&result, models.SearchCursor, args...)
+	} else {
+		err = client.RunCmd(&result, qm.Command, args...)
+	}
+
+	// Check error
+	if err != nil {
+		if cursor && strings.Contains(strings.ToLower(err.Error()), "cursor not found") {
+			err = fmt.Errorf("cursor %s is expired or read to the end", qm.Cursor)
+		} else if cursor {
+			deleteFtCursor(qm, client)
+		}
+
+		return errorHandler(response, err)
+	}
+
+	// Results are followed by the next cursor, which is 0 when all results are read and cursor is deleted
+	var frameCursor *data.Frame
+	if cursor || qm.WithCursor {
+		nextCursor := "0"
+		if len(result) > 1 {
+			nextCursor = replyToString(result[1])
+		}
+
+		if len(result) > 0 {
+			result, _ = result[0].([]interface{})
+		}
+
+		frameCursor = data.NewFrame("Cursor", data.NewField("cursor", nil, []string{nextCursor}))
+	}
+
+	// First element is the number of results
+	rows := make([]map[string]interface{}, 0, len(result))
+	var columns []string
+	for i := 1; i < len(result); i++ {
+		values, ok := result[i].([]interface{})
+		if !ok {
+			continue
+		}
+
+		row := map[string]interface{}{}
+		for j := 0; j+1 < len(values); j += 2 {
+			name := replyToString(values[j])
+			if !containsString(columns, name) {
+				columns = append(columns, name)
+			}
+
+			row[name] = values[j+1]
+		}
+
+		rows = append(rows, row)
+	}
+
+	// Add number of rows to the cursor frame
+	if frameCursor != nil {
+		frameCursor.Fields = append(frameCursor.Fields, data.NewField("count", nil, []int64{int64(len(rows))}))
+	}
+
+	// Return results as a table
+	if qm.SearchTimeField == "" {
+		response.Frames = append(response.Frames, createFtAggregateFrame(qm.Key, columns, rows))
+		if frameCursor != nil {
+			response.Frames = append(response.Frames, frameCursor)
+		}
+
+		return response
+	}
+
+	// Time bucket is the first column and rows are sorted by time
+	var timeRows []map[string]interface{}
+	var timestamps []time.Time
+	var valueColumns []string
+	for _, column := range columns {
+		if column != models.SearchTimeProperty {
+			valueColumns = append(valueColumns, column)
+		}
+	}
+
+	for _, row := range rows {
+		bucket, err := strconv.ParseFloat(replyToString(row[models.SearchTimeProperty]), 64)
+		if err != nil {
+			continue
+		}
+
+		timeRows = append(timeRows, row)
+		timestamps = append(timestamps, ftTime(bucket, qm.SearchTimeUnit))
+	}
+
+	sort.Sort(ftTimeRows{timestamps: timestamps, rows: timeRows})
+
+	frame := createFtAggregateFrame(qm.Key, valueColumns, timeRows)
+	frame.Fields = append([]*data.Field{data.NewField("time", nil, timestamps)}, frame.Fields...)
+	frame.Meta = &data.FrameMeta{Type: data.FrameTypeTimeSeriesLong}
+
+	// Add the frames to the response
+	response.Frames = append(response.Frames, frame)
+	if frameCursor != nil {
+		response.Frames = append(response.Frames, frameCursor)
+	}
+
+	// Return Response
+	return response
+}
+
+/**
+ * Arguments for FT.AGGREGATE from the pipeline
+ */
+func ftAggregateArgs(from int64, to int64, qm queryModel) ([]string, error) {
+	query := qm.SearchQuery
+	if query == "" {
+		query = "*"
+	}
+
+	var pipeline []string
+
+	// Time buckets
+	if qm.SearchTimeField != "" {
+		if qm.Bucket <= 0 {
+			return nil, errors.New("bucket is required for the timestamp field")
+		}
+
+		field := ftProperty(qm.SearchTimeField)
+
+		// Bucket in the units of the field
+		bucket := float64(qm.Bucket)
+		if qm.SearchTimeUnit == models.SearchTimeUnitSeconds {
+			bucket /= 1000
+		}
+
+		// Documents in the time range
+		query = ftTimeQuery(from, to, query, qm)
+
+		pipeline = append(pipeline, "APPLY", fmt.Sprintf("floor(%s/%s)*%s", field, ftNumber(bucket), ftNumber(bucket)), "AS", models.SearchTimeProperty)
+	}
+
+	// Pipeline
+	for _, step := range qm.SearchPipeline {
+		step.Fields = ftList(step.Fields)
+
+		switch strings.ToLower(step.Type) {
+		case models.SearchStepLoad:
+			if len(step.Fields) == 0 {
+				return nil, errors.New("fields are required for LOAD")
+			}
+
+			pipeline = append(pipeline, "LOAD", strconv.Itoa(len(step.Fields)))
+			for _, field := range step.Fields {
+				pipeline = append(pipeline, ftProperty(field))
+			}
+		case models.SearchStepGroupBy:
+			fields := step.Fields
+			if qm.SearchTimeField != "" {
+				fields = append([]string{models.SearchTimeProperty}, fields...)
+			}
+
+			if len(fields) == 0 {
+				return nil, errors.New("fields are required for GROUPBY")
+			}
+
+			pipeline = append(pipeline, "GROUPBY", strconv.Itoa(len(fields)))
+			for _, field := range fields {
+				pipeline = append(pipeline, ftProperty(field))
+			}
+
+			for _, reducer := range step.Reducers {
+				if reducer.Function == "" {
+					return nil, errors.New("function is required for REDUCE")
+				}
+
+				reducerArgs := ftList(reducer.Args)
+				pipeline = append(pipeline, "REDUCE", strings.ToUpper(reducer.Function), strconv.Itoa(len(reducerArgs)))
+				pipeline = append(pipeline, reducerArgs...)
+				if reducer.As != "" {
+					pipeline = append(pipeline, "AS", reducer.As)
+				}
+			}
+		case models.SearchStepApply:
+			if step.Expression == "" || step.As == "" {
+				return nil, errors.New("expression and name are required for APPLY")
+			}
+
+			pipeline = append(pipeline, "APPLY", step.Expression, "AS", step.As)
+		case models.SearchStepFilter:
+			if step.Expression == "" {
+				return nil, errors.New("expression is required for FILTER")
+			}
+
+			pipeline = append(pipeline, "FILTER", step.Expression)
+		case models.SearchStepSortBy:
+			// Properties with optional direction, i.e. @count DESC
+			var properties []string
+			for _, field := range step.Fields {
+				for _, token := range strings.Fields(field) {
+					if upper := strings.ToUpper(token); upper == "ASC" || upper == "DESC" {
+						properties = append(properties, upper)
+					} else {
+						properties = append(properties, ftProperty(token))
+					}
+				}
+			}
+
+			if len(properties) == 0 {
+				return nil, errors.New("fields are required for SORTBY")
+			}
+
+			pipeline = append(pipeline, "SORTBY", strconv.Itoa(len(properties)))
+			pipeline = append(pipeline, properties...)
+			if step.Max > 0 {
+				pipeline = append(pipeline, "MAX", strconv.Itoa(step.Max))
+			}
+		case models.SearchStepLimit:
+			if step.Count <= 0 {
+				return nil, errors.New("count is required for LIMIT")
+			}
+
+			pipeline = append(pipeline, "LIMIT", strconv.Itoa(step.Offset), strconv.Itoa(step.Count))
+		default:
+			return nil, fmt.Errorf("pipeline step is not supported: %s", step.Type)
+		}
+	}
+
+	// Query parameters and dialect
+	params, err := ftParamsArgs(qm)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline = append(pipeline, params...)
+
+	return append([]string{qm.Key, query}, pipeline...), nil
+}
+
+/**
+ * WITHCURSOR arguments with the number of rows per page and idle time
+ */
+func ftCursorArgs(qm queryModel) []string {
+	args := []string{"WITHCURSOR"}
+	if qm.Count > 0 {
+		args = append(args, "COUNT", strconv.Itoa(qm.Count))
+	}
+
+	maxIdle := qm.MaxIdle
+	if maxIdle <= 0 {
+		maxIdle = models.SearchCursorMaxIdle
+	}
+
+	return append(args, "MAXIDLE", strconv.Itoa(maxIdle))
+}
+
+/**
+ * Delete the cursor, error is logged as cursor can be already deleted by RediSearch after idle time
+ */
+func deleteFtCursor(qm queryModel, client redisClient) {
+	var result string
+	if err := client.RunCmd(&result, models.SearchCursor, "DEL", qm.Key, qm.Cursor); err != nil {
+		log.DefaultLogger.Debug(models.SearchCursor, "cursor", qm.Cursor, "error", err)
+	}
+}
+
+/**
+ * Data frame with numeric columns when all values are numbers, otherwise string columns
+ */
+func createFtAggregateFrame(name string, columns []string, rows []map[string]interface{}) *data.Frame {
+	frame := data.NewFrame(name)
+
+	for _, column := range columns {
+		numbers := make([]*float64, len(rows))
+		strs := make([]*string, len(rows))
+		numeric := true
+
+		for i, row := range rows {
+			value, ok := row[column]
+			if !ok || value == nil {
+				continue
+			}
+
+			str := replyToString(value)
+			strs[i] = &str
+
+			if number, err := strconv.ParseFloat(str, 64); err == nil {
+				numbers[i] = &number
+			} else {
+				numeric = false
+			}
+		}
+
+		if numeric {
+			frame.Fields = append(frame.Fields, data.NewField(column, nil, numbers))
+		} else {
+			frame.Fields = append(frame.Fields, data.NewField(column, nil, strs))
+		}
+	}
+
+	return frame
+}
+
+/**
+ * Rows sorted by time
+ */
+type ftTimeRows struct {
+	timestamps []time.Time
+	rows       []map[string]interface{}
+}
+
+func (r ftTimeRows) Len() int           { return len(r.rows) }
+func (r ftTimeRows) Less(i, j int) bool { return r.timestamps[i].Before(r.timestamps[j]) }
+func (r ftTimeRows) Swap(i, j int) {
+	r.timestamps[i], r.timestamps[j] = r.timestamps[j], r.timestamps[i]
+	r.rows[i], r.rows[j] = r.rows[j], r.rows[i]
+}
+
+/**
+ * Property name with @ prefix
+ */
+func ftProperty(name string) string {
+	if strings.HasPrefix(name, "@") {
+		return name
+	}
+
+	return "@" + name
+}
+
+/**
+ * Number without trailing zeros
+ */
+func ftNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+/**
+ * Query limited to the documents with the timestamp field in the time range
+ */
+func ftTimeQuery(from int64, to int64, query string, qm queryModel) string {
+	// Timestamps in the units of the field
+	start, end := float64(from), float64(to)
+	if qm.SearchTimeUnit == models.SearchTimeUnitSeconds {
+		start, end = math.Floor(start/1000), math.Ceil(end/1000)
+	}
+
+	timeRange := fmt.Sprintf("%s:[%s %s]", ftProperty(qm.SearchTimeField), ftNumber(start), ftNumber(end))
+	if strings.TrimSpace(query) == "*" {
+		return timeRange
+	}
+
+	return fmt.Sprintf("(%s) %s", query, timeRange)
+}
+
+/**
+ * Time from the timestamp in seconds or milliseconds
+ */
+func ftTime(value float64, unit string) time.Time {
+	if unit == models.SearchTimeUnitSeconds {
+		value *= 1000
+	}
+
+	return time.Unix(0, int64(value)*int64(time.Millisecond))
+}
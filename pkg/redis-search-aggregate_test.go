@@ -0,0 +1,255 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * FT.AGGREGATE
+ */
+func TestQueryFtAggregate(t *testing.T) {
+	t.Parallel()
+
+	row := func(values ...string) []interface{} {
+		var result []interface{}
+		for _, value := range values {
+			result = append(result, []byte(value))
+		}
+		return result
+	}
+
+	// Pipeline
+	t.Run("should build pipeline and return typed columns", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv: []interface{}{
+				int64(2),
+				row("country", "us", "count", "10", "avg_price", "1.5"),
+				row("country", "de", "count", "3"),
+			},
+			expectedCmd: models.SearchAggregate,
+			expectedArgs: []string{
+				"idx", "@type:{book}",
+				"LOAD", "1", "@price",
+				"GROUPBY", "1", "@country",
+				"REDUCE", "COUNT", "0", "AS", "count",
+				"REDUCE", "AVG", "1", "@price", "AS", "avg_price",
+				"APPLY", "@count*2", "AS", "double",
+				"FILTER", "@count>1",
+				"SORTBY", "2", "@count", "DESC", "MAX", "5",
+				"LIMIT", "0", "10",
+			},
+		}
+
+		qm := queryModel{Command: models.SearchAggregate, Key: "idx", SearchQuery: "@type:{book}", SearchPipeline: []searchStep{
+			{Type: models.SearchStepLoad, Fields: []string{"price"}},
+			{Type: models.SearchStepGroupBy, Fields: []string{"@country"}, Reducers: []searchReducer{
+				{Function: "count", As: "count"},
+				{Function: "avg", Args: []string{"@price"}, As: "avg_price"},
+			}},
+			{Type: models.SearchStepApply, Expression: "@count*2", As: "double"},
+			{Type: models.SearchStepFilter, Expression: "@count>1"},
+			{Type: models.SearchStepSortBy, Fields: []string{"@count desc"}, Max: 5},
+			{Type: models.SearchStepLimit, Count: 10},
+		}}
+
+		response := queryFtAggregate(0, 0, qm, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 1)
+
+		frame := response.Frames[0]
+		require.Equal(t, "idx", frame.Name)
+		require.Len(t, frame.Fields, 3)
+		require.Equal(t, "country", frame.Fields[0].Name)
+		require.Equal(t, "us", *frame.Fields[0].At(0).(*string))
+		require.Equal(t, "count", frame.Fields[1].Name)
+		require.Equal(t, 10.0, *frame.Fields[1].At(0).(*float64))
+		require.Equal(t, "avg_price", frame.Fields[2].Name)
+		require.Equal(t, 1.5, *frame.Fields[2].At(0).(*float64))
+		require.Nil(t, frame.Fields[2].At(1))
+	})
+
+	// Time buckets
+	t.Run("should return time-series with time buckets", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv: []interface{}{
+				int64(3),
+				row(models.SearchTimeProperty, "120", "host", "a", "count", "2"),
+				row(models.SearchTimeProperty, "60", "host", "a", "count", "1"),
+				row(models.SearchTimeProperty, "60", "host", "b", "count", "5"),
+			},
+			expectedCmd: models.SearchAggregate,
+			expectedArgs: []string{
+				"idx", "(@type:{event}) @ts:[0 180]",
+				"APPLY", "floor(@ts/60)*60", "AS", models.SearchTimeProperty,
+				"GROUPBY", "2", "@__time", "@host",
+				"REDUCE", "COUNT", "0", "AS", "count",
+			},
+		}
+
+		qm := queryModel{Command: models.SearchAggregate, Key: "idx", SearchQuery: "@type:{event}", SearchTimeField: "ts",
+			SearchTimeUnit: models.SearchTimeUnitSeconds, Bucket: 60000, SearchPipeline: []searchStep{
+				{Type: models.SearchStepGroupBy, Fields: []string{"host"}, Reducers: []searchReducer{{Function: "COUNT", As: "count"}}},
+			}}
+
+		response := queryFtAggregate(0, 179500, qm, &client)
+		require.NoError(t, response.Error)
+
+		frame := response.Frames[0]
+		require.Equal(t, data.FrameTypeTimeSeriesLong, frame.Meta.Type)
+		require.Len(t, frame.Fields, 3)
+		require.Equal(t, "time", frame.Fields[0].Name)
+		require.Equal(t, time.Unix(60, 0), frame.Fields[0].At(0))
+		require.Equal(t, time.Unix(60, 0), frame.Fields[0].At(1))
+		require.Equal(t, time.Unix(120, 0), frame.Fields[0].At(2))
+		require.Equal(t, "host", frame.Fields[1].Name)
+		require.Equal(t, "a", *frame.Fields[1].At(2).(*string))
+		require.Equal(t, 2.0, *frame.Fields[2].At(2).(*float64))
+	})
+
+	// Time buckets in milliseconds with wildcard query
+	t.Run("should use time range as query in milliseconds", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := ftAggregateArgs(1000, 2000, queryModel{Key: "idx", SearchTimeField: "@ts", Bucket: 500})
+		require.NoError(t, err)
+		require.Equal(t, []string{"idx", "@ts:[1000 2000]", "APPLY", "floor(@ts/500)*500", "AS", models.SearchTimeProperty}, args)
+	})
+
+	// Lists are split by the editor without trimming
+	t.Run("should trim fields and reducer arguments", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := ftAggregateArgs(0, 0, queryModel{Key: "idx", SearchPipeline: []searchStep{
+			{Type: models.SearchStepGroupBy, Fields: []string{"@country", " @city", " "}, Reducers: []searchReducer{
+				{Function: "quantile", Args: []string{"@price", " 0.5", ""}, As: "median"},
+			}},
+			{Type: models.SearchStepSortBy, Fields: []string{"@median DESC", " @country "}},
+		}})
+		require.NoError(t, err)
+		require.Equal(t, []string{"idx", "*",
+			"GROUPBY", "2", "@country", "@city",
+			"REDUCE", "QUANTILE", "2", "@price", "0.5", "AS", "median",
+			"SORTBY", "3", "@median", "DESC", "@country",
+		}, args)
+	})
+
+	// Cursor
+	t.Run("should return cursor", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:          []interface{}{[]interface{}{int64(3), row("country", "us"), row("country", "de")}, int64(42)},
+			expectedCmd:  models.SearchAggregate,
+			expectedArgs: []string{"idx", "*", "GROUPBY", "1", "@country", "WITHCURSOR", "COUNT", "2", "MAXIDLE", "60000"},
+		}
+
+		qm := queryModel{Command: models.SearchAggregate, Key: "idx", WithCursor: true, Count: 2, SearchPipeline: []searchStep{
+			{Type: models.SearchStepGroupBy, Fields: []string{"country"}},
+		}}
+
+		response := queryFtAggregate(0, 0, qm, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 2)
+		require.Equal(t, 2, response.Frames[0].Rows())
+		require.Equal(t, "Cursor", response.Frames[1].Name)
+		require.Equal(t, "42", response.Frames[1].Fields[0].At(0))
+		require.Equal(t, int64(2), response.Frames[1].Fields[1].At(0))
+	})
+
+	t.Run("should read cursor", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:          []interface{}{[]interface{}{int64(0), row("country", "fr")}, int64(0)},
+			expectedCmd:  models.SearchCursor,
+			expectedArgs: []string{"READ", "idx", "42", "COUNT", "2"},
+		}
+
+		response := queryFtAggregate(0, 0, queryModel{Command: models.SearchAggregate, Key: "idx", WithCursor: true, Cursor: "42", Count: 2}, &client)
+		require.NoError(t, response.Error)
+		require.Equal(t, "fr", *response.Frames[0].Fields[0].At(0).(*string))
+		require.Equal(t, "0", response.Frames[1].Fields[0].At(0))
+	})
+
+	t.Run("should handle expired cursor", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{err: errors.New("Cursor not found")}
+		response := queryFtAggregate(0, 0, queryModel{Command: models.SearchAggregate, Key: "idx", WithCursor: true, Cursor: "42"}, &client)
+		require.EqualError(t, response.Error, "cursor 42 is expired or read to the end")
+		require.Equal(t, []string{"ft.cursor READ idx 42"}, client.calls)
+
+		response = queryFtAggregate(0, 0, queryModel{Command: models.SearchAggregate, Key: "idx", WithCursor: true, Cursor: "next"}, &testClient{})
+		require.EqualError(t, response.Error, "cursor is not valid: next")
+	})
+
+	t.Run("should delete cursor if read failed", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{cmdRcv: map[string]interface{}{
+			models.SearchCursor + " READ idx 42": errors.New("Timeout limit was reached"),
+			models.SearchCursor + " DEL idx 42":  "OK",
+		}}
+
+		response := queryFtAggregate(0, 0, queryModel{Command: models.SearchAggregate, Key: "idx", WithCursor: true, Cursor: "42"}, &client)
+		require.EqualError(t, response.Error, "Timeout limit was reached")
+		require.Equal(t, []string{"ft.cursor READ idx 42", "ft.cursor DEL idx 42"}, client.calls)
+	})
+
+	t.Run("should delete cursor if reading is stopped", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:    []interface{}{int64(1), row("country", "us")},
+			cmdRcv: map[string]interface{}{models.SearchCursor: "OK"},
+		}
+
+		response := queryFtAggregate(0, 0, queryModel{Command: models.SearchAggregate, Key: "idx", Cursor: "42"}, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 1)
+		require.Equal(t, "us", *response.Frames[0].Fields[0].At(0).(*string))
+		require.Equal(t, []string{"ft.cursor DEL idx 42", "ft.aggregate idx *"}, client.calls)
+	})
+
+	// Validation
+	t.Run("should validate pipeline", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			qm  queryModel
+			err string
+		}{
+			{queryModel{SearchTimeField: "ts"}, "bucket is required for the timestamp field"},
+			{queryModel{SearchPipeline: []searchStep{{Type: models.SearchStepGroupBy}}}, "fields are required for GROUPBY"},
+			{queryModel{SearchPipeline: []searchStep{{Type: models.SearchStepGroupBy, Fields: []string{"a"}, Reducers: []searchReducer{{}}}}}, "function is required for REDUCE"},
+			{queryModel{SearchPipeline: []searchStep{{Type: models.SearchStepApply, Expression: "@a"}}}, "expression and name are required for APPLY"},
+			{queryModel{SearchPipeline: []searchStep{{Type: models.SearchStepFilter}}}, "expression is required for FILTER"},
+			{queryModel{SearchPipeline: []searchStep{{Type: models.SearchStepSortBy}}}, "fields are required for SORTBY"},
+			{queryModel{SearchPipeline: []searchStep{{Type: models.SearchStepLimit}}}, "count is required for LIMIT"},
+			{queryModel{SearchPipeline: []searchStep{{Type: "unknown"}}}, "pipeline step is not supported: unknown"},
+		}
+
+		for _, tt := range tests {
+			response := queryFtAggregate(0, 0, tt.qm, &testClient{})
+			require.EqualError(t, response.Error, tt.err)
+		}
+	})
+
+	// Error
+	t.Run("should handle error", func(t *testing.T) {
+		t.Parallel()
+
+		response := queryFtAggregate(0, 0, queryModel{Command: models.SearchAggregate, Key: "idx"}, &testClient{err: errors.New("error occurred")})
+		require.EqualError(t, response.Error, "error occurred")
+	})
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+)
+
+/**
+ * FT.EXPLAIN {index} {query} [DIALECT]
+ * FT.EXPLAINCLI {index} {query} [DIALECT]
+ *
+ * Returns the execution plan for a complex query.
+ *
+ * @see https://redis.io/commands/ft.explain
+ * @see https://redis.io/commands/ft.explaincli
+ */
+func queryFtExplain(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	args := []string{qm.Key, qm.SearchQuery}
+	if qm.SearchQuery == "" {
+		args[1] = "*"
+	}
+
+	if qm.Dialect > 0 {
+		args = append(args, "DIALECT", strconv.Itoa(qm.Dialect))
+	}
+
+	// Execute command
+	var result interface{}
+	err := client.RunCmd(&result, qm.Command, args...)
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// New Frame
+	frame := data.NewFrame(qm.Command)
+	frame.Fields = append(frame.Fields, data.NewField("execution plan", nil, []string{}))
+	response.Frames = append(response.Frames, frame)
+
+	// FT.EXPLAIN returns tree as a string, FT.EXPLAINCLI as an array of lines
+	var lines []string
+	switch value := result.(type) {
+	case []interface{}:
+		for _, line := range value {
+			lines = append(lines, replyToString(line))
+		}
+	default:
+		lines = strings.Split(strings.TrimRight(replyToString(value), "\n"), "\n")
+	}
+
+	// Entries
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			frame.AppendRow(line)
+		}
+	}
+
+	// Return
+	return response
+}
+
+/**
+ * FT.PROFILE {index} SEARCH|AGGREGATE QUERY {query} [...]
+ *
+ * Returns timings of the query execution with iterators and result processors.
+ *
+ * @see https://redis.io/commands/ft.profile
+ */
+func queryFtProfile(from int64, to int64, qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Arguments of the profiled command
+	var args []string
+	var err error
+
+	profile := strings.ToUpper(qm.SearchProfile)
+	switch profile {
+	case models.SearchProfileAggregate:
+		args, err = ftAggregateArgs(from, to, qm)
+	case "", models.SearchProfileSearch:
+		profile = models.SearchProfileSearch
+		args, err = ftSearchArgs(from, to, qm)
+	default:
+		err = fmt.Errorf("profile is not supported: %s", qm.SearchProfile)
+	}
+
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	args = append([]string{args[0], profile, "QUERY"}, args[1:]...)
+
+	// Execute command
+	var result []interface{}
+	err = client.RunCmd(&result, qm.Command, args...)
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// Results are followed by the profile
+	var entries []interface{}
+	if len(result) > 1 {
+		entries, _ = result[1].([]interface{})
+	}
+
+	// Timings
+	frame := data.NewFrame(qm.Command)
+
+	// Stages
+	stages := data.NewFrame("stages",
+		data.NewField("Stage", nil, []string{}),
+		data.NewField("Level", nil, []int64{}),
+		data.NewField("Type", nil, []string{}),
+		data.NewField("Details", nil, []string{}),
+		data.NewField("Time", nil, []float64{}),
+		data.NewField("Counter", nil, []int64{}))
+	stages.Fields[4].Config = &data.FieldConfig{Unit: "ms"}
+
+	for _, value := range entries {
+		entry, ok := value.([]interface{})
+		if !ok || len(entry) < 2 {
+			continue
+		}
+
+		name := replyToString(entry[0])
+		switch strings.ToLower(name) {
+		case "iterators profile":
+			for _, iterator := range ftProfileItems(entry[1:]) {
+				addFtProfileStage(stages, "Iterator", 0, iterator)
+			}
+		case "result processors profile":
+			for _, processor := range ftProfileItems(entry[1:]) {
+				addFtProfileStage(stages, "Processor", 0, processor)
+			}
+		default:
+			if number, err := strconv.ParseFloat(replyToString(entry[1]), 64); err == nil {
+				field := data.NewField(name, nil, []float64{number})
+				if strings.HasSuffix(strings.ToLower(name), "time") {
+					field.Config = &data.FieldConfig{Unit: "ms"}
+				}
+
+				frame.Fields = append(frame.Fields, field)
+			} else {
+				frame.Fields = append(frame.Fields, data.NewField(name, nil, []string{replyToString(entry[1])}))
+			}
+		}
+	}
+
+	// Add the frames to the response
+	response.Frames = append(response.Frames, frame, stages)
+
+	// Return
+	return response
+}
+
+/**
+ * Iterators or processors, which are returned as a list of properties or a list of lists
+ */
+func ftProfileItems(values []interface{}) [][]interface{} {
+	var items [][]interface{}
+
+	for _, value := range values {
+		item, ok := value.([]interface{})
+		if !ok || len(item) == 0 {
+			continue
+		}
+
+		// Properties start with the type
+		if _, nested := item[0].([]interface{}); !nested {
+			items = append(items, item)
+			continue
+		}
+
+		items = append(items, ftProfileItems(item)...)
+	}
+
+	return items
+}
+
+/**
+ * Add iterator or processor with child iterators to the stages
+ */
+func addFtProfileStage(frame *data.Frame, stage string, level int64, properties []interface{}) {
+	var stageType string
+	var details []string
+	var duration float64
+	var counter int64
+	var children []interface{}
+
+	for i := 0; i+1 < len(properties); i += 2 {
+		name := replyToString(properties[i])
+
+		switch strings.ToLower(name) {
+		case "type":
+			stageType = replyToString(properties[i+1])
+		case "time":
+			duration, _ = strconv.ParseFloat(replyToString(properties[i+1]), 64)
+		case "counter":
+			counter, _ = strconv.ParseInt(replyToString(properties[i+1]), 10, 64)
+		case "child iterator", "child iterators":
+			// Children are the rest of the properties
+			children = properties[i+1:]
+			i = len(properties)
+		default:
+			details = append(details, name+": "+replyToString(properties[i+1]))
+		}
+	}
+
+	frame.AppendRow(stage, level, stageType, strings.Join(details, ", "), duration, counter)
+
+	for _, child := range ftProfileItems(children) {
+		addFtProfileStage(frame, stage, level+1, child)
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * FT.EXPLAIN and FT.EXPLAINCLI
+ */
+func TestQueryFtExplain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		qm           queryModel
+		rcv          interface{}
+		expectedArgs []string
+		lines        []string
+		err          error
+	}{
+		{
+			"should split execution plan",
+			queryModel{Command: models.SearchExplain, Key: "idx", SearchQuery: "@title:foo @price:[0 10]", Dialect: 2},
+			[]byte("INTERSECT {\n  @title:foo\n  NUMERIC {0.000000 <= @price <= 10.000000}\n}\n"),
+			[]string{"idx", "@title:foo @price:[0 10]", "DIALECT", "2"},
+			[]string{"INTERSECT {", "  @title:foo", "  NUMERIC {0.000000 <= @price <= 10.000000}", "}"},
+			nil,
+		},
+		{
+			"should return lines",
+			queryModel{Command: models.SearchExplainCli, Key: "idx"},
+			[]interface{}{[]byte("INTERSECT {"), []byte("  @title:foo"), []byte(""), []byte("}")},
+			[]string{"idx", "*"},
+			[]string{"INTERSECT {", "  @title:foo", "}"},
+			nil,
+		},
+		{
+			"should handle error",
+			queryModel{Command: models.SearchExplain, Key: "idx"},
+			nil,
+			nil,
+			nil,
+			errors.New("error occurred"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := testClient{rcv: tt.rcv, err: tt.err, expectedArgs: tt.expectedArgs, expectedCmd: tt.qm.Command}
+			response := queryFtExplain(tt.qm, &client)
+
+			if tt.err != nil {
+				require.EqualError(t, response.Error, tt.err.Error())
+				return
+			}
+
+			require.NoError(t, response.Error)
+			require.Equal(t, "execution plan", response.Frames[0].Fields[0].Name)
+			require.Equal(t, len(tt.lines), response.Frames[0].Fields[0].Len())
+			for i, line := range tt.lines {
+				require.Equal(t, line, response.Frames[0].Fields[0].At(i))
+			}
+		})
+	}
+}
+
+/**
+ * FT.PROFILE
+ */
+func TestQueryFtProfile(t *testing.T) {
+	t.Parallel()
+
+	profile := []interface{}{
+		[]interface{}{[]byte("Total profile time"), []byte("0.5")},
+		[]interface{}{[]byte("Parsing time"), []byte("0.1")},
+		[]interface{}{[]byte("Iterators profile"), []interface{}{
+			[]byte("Type"), []byte("INTERSECT"), []byte("Time"), []byte("0.3"), []byte("Counter"), int64(2),
+			[]byte("Child iterators"),
+			[]interface{}{[]byte("Type"), []byte("TEXT"), []byte("Term"), []byte("foo"), []byte("Time"), []byte("0.1"), []byte("Counter"), int64(5)},
+			[]interface{}{[]byte("Type"), []byte("NUMERIC"), []byte("Term"), []byte("0 - 10"), []byte("Time"), []byte("0.15"), []byte("Counter"), int64(3)},
+		}},
+		[]interface{}{[]byte("Result processors profile"),
+			[]interface{}{[]byte("Type"), []byte("Index"), []byte("Time"), []byte("0.35"), []byte("Counter"), int64(2)},
+			[]interface{}{[]byte("Type"), []byte("Scorer"), []byte("Time"), []byte("0.05"), []byte("Counter"), int64(2)},
+		},
+	}
+
+	t.Run("should parse search profile", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:          []interface{}{[]interface{}{int64(0)}, profile},
+			expectedCmd:  models.SearchProfile,
+			expectedArgs: []string{"idx", "SEARCH", "QUERY", "@title:foo", "LIMIT", "0", "5"},
+		}
+
+		response := queryFtProfile(0, 0, queryModel{Command: models.SearchProfile, Key: "idx", SearchQuery: "@title:foo", Count: 5}, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 2)
+
+		// Timings
+		frame := response.Frames[0]
+		require.Len(t, frame.Fields, 2)
+		require.Equal(t, "Total profile time", frame.Fields[0].Name)
+		require.Equal(t, 0.5, frame.Fields[0].At(0))
+		require.Equal(t, "ms", frame.Fields[0].Config.Unit)
+
+		// Stages
+		stages := response.Frames[1]
+		require.Equal(t, 5, stages.Rows())
+
+		row := func(i int) []interface{} {
+			var values []interface{}
+			for _, field := range stages.Fields {
+				values = append(values, field.At(i))
+			}
+			return values
+		}
+
+		require.Equal(t, []interface{}{"Iterator", int64(0), "INTERSECT", "", 0.3, int64(2)}, row(0))
+		require.Equal(t, []interface{}{"Iterator", int64(1), "TEXT", "Term: foo", 0.1, int64(5)}, row(1))
+		require.Equal(t, []interface{}{"Iterator", int64(1), "NUMERIC", "Term: 0 - 10", 0.15, int64(3)}, row(2))
+		require.Equal(t, []interface{}{"Processor", int64(0), "Index", "", 0.35, int64(2)}, row(3))
+		require.Equal(t, []interface{}{"Processor", int64(0), "Scorer", "", 0.05, int64(2)}, row(4))
+	})
+
+	t.Run("should profile aggregate", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:          []interface{}{[]interface{}{int64(0)}, profile},
+			expectedArgs: []string{"idx", "AGGREGATE", "QUERY", "*", "GROUPBY", "1", "@country"},
+		}
+
+		qm := queryModel{Command: models.SearchProfile, Key: "idx", SearchProfile: "aggregate", SearchPipeline: []searchStep{
+			{Type: models.SearchStepGroupBy, Fields: []string{"country"}},
+		}}
+
+		response := queryFtProfile(0, 0, qm, &client)
+		require.NoError(t, response.Error)
+		require.Equal(t, 5, response.Frames[1].Rows())
+	})
+
+	t.Run("should validate profile", func(t *testing.T) {
+		t.Parallel()
+
+		response := queryFtProfile(0, 0, queryModel{Command: models.SearchProfile, Key: "idx", SearchProfile: "explain"}, &testClient{})
+		require.EqualError(t, response.Error, "profile is not supported: explain")
+	})
+
+	t.Run("should handle error", func(t *testing.T) {
+		t.Parallel()
+
+		response := queryFtProfile(0, 0, queryModel{Command: models.SearchProfile, Key: "idx"}, &testClient{err: errors.New("error occurred")})
+		require.EqualError(t, response.Error, "error occurred")
+	})
+}
@@ -1,80 +1,468 @@
 package main
 
 import (
-  "strconv"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
 
-  "github.com/grafana/grafana-plugin-sdk-go/backend"
-  "github.com/grafana/grafana-plugin-sdk-go/backend/log"
-  "github.com/grafana/grafana-plugin-sdk-go/data"
-  "github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/backend/log"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
 )
 
-func queryFtSearch(qm queryModel, client redisClient) backend.DataResponse {
-  response := backend.DataResponse{}
+/**
+ * FT.SEARCH {index} {query} [NOCONTENT] [VERBATIM] [WITHSCORES] [INKEYS] [INFIELDS] [RETURN] [SUMMARIZE] [HIGHLIGHT] [LIMIT] [SORTBY]
+ *
+ * Field types are resolved from the index schema returned by FT.INFO.
+ * Total number of results is returned in the frame meta.
+ * With the limit offset of the next page is returned in the Cursor frame and used instead of the offset by the following query.
+ * With the time filter only documents with the timestamp field in the time range are returned.
+ *
+ * @see https://redis.io/commands/ft.search
+ */
+func queryFtSearch(from int64, to int64, qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Cursor returned by the previous query
+	if qm.Cursor != "" {
+		offset, err := strconv.Atoi(qm.Cursor)
+		if err != nil || offset < 0 {
+			return errorHandler(response, fmt.Errorf("cursor is not valid: %s", qm.Cursor))
+		}
+
+		qm.Offset = offset
+	}
+
+	// Arguments
+	args, err := ftSearchArgs(from, to, qm)
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// Index schema, documents are not returned without content
+	var info map[string]interface{}
+	if !qm.NoContent {
+		// Fields are returned as strings if schema is not available, i.e. FT.INFO is not allowed by ACL
+		if err := client.RunCmd(&info, models.SearchInfo, qm.Key); err != nil {
+			log.DefaultLogger.Warn(models.SearchInfo, "error", err)
+			info = nil
+		}
+	}
+
+	var result interface{}
+	err = client.RunCmd(&result, qm.Command, args...)
+
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// First element is the total number of results followed by key names, scores and fields
+	values, _ := result.([]interface{})
+	var total int64
+	var keys []string
+	var scores []float64
+	var docs []map[string]interface{}
+	var found []string
+
+	if len(values) > 0 {
+		total, _ = strconv.ParseInt(replyToString(values[0]), 10, 64)
+	}
+
+	for i := 1; i < len(values); i++ {
+		keys = append(keys, replyToString(values[i]))
+		doc := map[string]interface{}{}
+
+		if qm.WithScores && i+1 < len(values) {
+			i++
+			score, _ := strconv.ParseFloat(replyToString(values[i]), 64)
+			scores = append(scores, score)
+		}
+
+		if !qm.NoContent && i+1 < len(values) {
+			i++
+			fields, _ := values[i].([]interface{})
+			for j := 0; j+1 < len(fields); j += 2 {
+				name := replyToString(fields[j])
+				if !containsString(found, name) {
+					found = append(found, name)
+				}
+
+				doc[name] = fields[j+1]
+			}
+		}
+
+		docs = append(docs, doc)
+	}
+
+	// Columns in the order of return fields, schema and results
+	attributes := ftAttributes(info)
+	types := map[string]string{}
+	var columns []string
+	for _, name := range qm.ReturnFields {
+		if !qm.NoContent && !containsString(columns, name) {
+			columns = append(columns, name)
+		}
+	}
+
+	for _, attribute := range attributes {
+		types[attribute.name] = attribute.fieldType
+		if !containsString(columns, attribute.name) && containsString(found, attribute.name) {
+			columns = append(columns, attribute.name)
+		}
+	}
+
+	for _, name := range found {
+		if !containsString(columns, name) {
+			columns = append(columns, name)
+		}
+	}
+
+	// Create data frame response
+	frame := data.NewFrame("Results", data.NewField("keyName", nil, keys))
+	if qm.WithScores {
+		frame.Fields = append(frame.Fields, data.NewField("score", nil, scores))
+	}
+
+	timeField := strings.TrimPrefix(qm.SearchTimeField, "@")
+
+	for _, name := range columns {
+		switch {
+		case timeField != "" && name == timeField:
+			field := data.NewField(name, nil, make([]*time.Time, len(docs)))
+			for i, doc := range docs {
+				if value, err := strconv.ParseFloat(replyToString(doc[name]), 64); err == nil {
+					ts := ftTime(value, qm.SearchTimeUnit)
+					field.Set(i, &ts)
+				}
+			}
+
+			frame.Fields = append(frame.Fields, field)
+		case types[name] == models.SearchFieldNumeric || ftScoreRegexp.MatchString(name):
+			field := data.NewField(name, nil, make([]*float64, len(docs)))
+			for i, doc := range docs {
+				if value, err := strconv.ParseFloat(replyToString(doc[name]), 64); err == nil {
+					field.Set(i, &value)
+				}
+			}
+
+			frame.Fields = append(frame.Fields, field)
+		default:
+			field := data.NewField(name, nil, make([]*string, len(docs)))
+			for i, doc := range docs {
+				if value, ok := doc[name]; ok && value != nil {
+					str := replyToString(value)
+					field.Set(i, &str)
+				}
+			}
+
+			frame.Fields = append(frame.Fields, field)
+		}
+	}
+
+	// Total number of results
+	frame.Meta = &data.FrameMeta{Stats: []data.QueryStat{{FieldConfig: data.FieldConfig{DisplayName: "Total"}, Value: float64(total)}}}
+
+	// Offset of the next page, 0 when all results are read
+	nextCursor := "0"
+	if next := int64(qm.Offset + len(keys)); len(keys) > 0 && next < total {
+		nextCursor = strconv.FormatInt(next, 10)
+		frame.AppendNotices(data.Notice{
+			Severity: data.NoticeSeverityWarning,
+			Text:     fmt.Sprintf("Returned %d of %d results, use cursor %s to read the next page", len(keys), total, nextCursor),
+		})
+	}
+
+	response.Frames = append(response.Frames, frame)
+
+	// Cursor is returned only for paging
+	if qm.Count > 0 || qm.Cursor != "" {
+		response.Frames = append(response.Frames, data.NewFrame("Cursor",
+			data.NewField("cursor", nil, []string{nextCursor}),
+			data.NewField("count", nil, []int64{int64(len(keys))})))
+	}
+
+	return response
+}
+
+/**
+ * Arguments for FT.SEARCH
+ */
+func ftSearchArgs(from int64, to int64, qm queryModel) ([]string, error) {
+	query := qm.SearchQuery
+	if query == "" {
+		query = "*"
+	}
+
+	// Documents in the time range
+	if qm.SearchTimeFilter && qm.SearchTimeField != "" {
+		query = ftTimeQuery(from, to, query, qm)
+	}
+
+	args := []string{qm.Key, query}
+
+	if qm.NoContent {
+		args = append(args, "NOCONTENT")
+	}
+
+	if qm.Verbatim {
+		args = append(args, "VERBATIM")
+	}
+
+	if qm.WithScores {
+		args = append(args, "WITHSCORES")
+	}
+
+	inKeys, inFields := ftList(qm.InKeys), ftList(qm.InFields)
+	if len(inKeys) > 0 {
+		args = append(args, "INKEYS", strconv.Itoa(len(inKeys)))
+		args = append(args, inKeys...)
+	}
+
+	if len(inFields) > 0 {
+		args = append(args, "INFIELDS", strconv.Itoa(len(inFields)))
+		args = append(args, inFields...)
+	}
+
+	if !qm.NoContent && qm.ReturnFields != nil && len(qm.ReturnFields) > 0 {
+		args = append(args, "RETURN")
+		args = append(args, strconv.Itoa(len(qm.ReturnFields)))
+		args = append(args, qm.ReturnFields...)
+	}
 
-  var result interface{}
-  args := []string{qm.Key}
-  if qm.SearchQuery == "" {
-    args = append(args, "*")
-  } else {
-    args = append(args, qm.SearchQuery)
-  }
+	if qm.Summarize {
+		summarizeFields := ftList(qm.SummarizeFields)
+		args = append(args, "SUMMARIZE")
+		if len(summarizeFields) > 0 {
+			args = append(args, "FIELDS", strconv.Itoa(len(summarizeFields)))
+			args = append(args, summarizeFields...)
+		}
 
-  if qm.ReturnFields != nil && len(qm.ReturnFields) > 0 {
-    args = append(args, "RETURN")
-    args = append(args, strconv.Itoa(len(qm.ReturnFields)))
-    args = append(args, qm.ReturnFields...)
-  }
+		if qm.SummarizeFrags > 0 {
+			args = append(args, "FRAGS", strconv.Itoa(qm.SummarizeFrags))
+		}
 
-  if qm.Count != 0 || qm.Offset > 0 {
-    var count int
-    if qm.Count == 0 {
-      count = 10
-    } else {
-      count = qm.Count
-    }
-    args = append(args, "LIMIT", strconv.Itoa(qm.Offset), strconv.Itoa(count))
-  }
+		if qm.SummarizeLen > 0 {
+			args = append(args, "LEN", strconv.Itoa(qm.SummarizeLen))
+		}
 
-  if qm.SortBy != "" {
-    args = append(args, "SORTBY", qm.SortBy, qm.SortDirection)
-  }
+		if qm.SummarizeSeparator != "" {
+			args = append(args, "SEPARATOR", qm.SummarizeSeparator)
+		}
+	}
 
-  err := client.RunCmd(&result, qm.Command, args...)
+	if qm.Highlight {
+		highlightFields := ftList(qm.HighlightFields)
+		args = append(args, "HIGHLIGHT")
+		if len(highlightFields) > 0 {
+			args = append(args, "FIELDS", strconv.Itoa(len(highlightFields)))
+			args = append(args, highlightFields...)
+		}
 
-  if err != nil {
-    return errorHandler(response, err)
-  }
+		if qm.HighlightOpen != "" || qm.HighlightClose != "" {
+			args = append(args, "TAGS", qm.HighlightOpen, qm.HighlightClose)
+		}
+	}
 
-  frame := data.NewFrame("Results")
-  fieldValuesMap := make(map[string][]string)
+	if qm.Count != 0 || qm.Offset > 0 {
+		var count int
+		if qm.Count == 0 {
+			count = 10
+		} else {
+			count = qm.Count
+		}
+		args = append(args, "LIMIT", strconv.Itoa(qm.Offset), strconv.Itoa(count))
+	}
 
-  fieldValuesMap["keyName"] = make([]string, len(result.([]interface{}))/2)
+	if qm.SortBy != "" {
+		args = append(args, "SORTBY", qm.SortBy, qm.SortDirection)
+	}
 
-  for i := 1; i < len(result.([]interface{})); i += 2 {
-    keyName := string((result.([]interface{}))[i].([]uint8))
-    fieldValuesMap["keyName"][i/2] = keyName
-    fieldValueArr := (result.([]interface{}))[i+1].([]interface{})
+	// Query parameters and dialect
+	params, err := ftParamsArgs(qm)
+	if err != nil {
+		return nil, err
+	}
 
-    for j := 0; j < len(fieldValueArr); j += 2 {
-      fieldName := string(fieldValueArr[j].([]uint8))
+	return append(args, params...), nil
+}
+
+/**
+ * List without spaces around and empty values
+ */
+func ftList(values []string) []string {
+	var result []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+/**
+ * Distance returned by the vector similarity query, i.e. __embedding_score
+ */
+var ftScoreRegexp = regexp.MustCompile(`^__.+_score$`)
+
+/**
+ * PARAMS and DIALECT arguments
+ *
+ * Vector parameters are encoded from the array of floats, otherwise value is decoded from base64.
+ */
+func ftParamsArgs(qm queryModel) ([]string, error) {
+	var args []string
+
+	if len(qm.SearchParams) > 0 {
+		args = append(args, "PARAMS", strconv.Itoa(len(qm.SearchParams)*2))
 
-      if _, ok := fieldValuesMap[fieldName]; !ok {
-        fieldValuesMap[fieldName] = make([]string, len(result.([]interface{}))/2)
-      }
+		for _, param := range qm.SearchParams {
+			if param.Name == "" {
+				return nil, errors.New("name is required for the query parameter")
+			}
 
-      fieldValue := string(fieldValueArr[j+1].([]uint8))
-      fieldValuesMap[fieldName][i/2] = fieldValue
-    }
-  }
+			value := param.Value
+			if param.Type == models.SearchParamFloat32 || param.Type == models.SearchParamFloat64 {
+				vector, err := ftVector(param.Value, param.Type)
+				if err != nil {
+					return nil, fmt.Errorf("parameter %s is not valid vector: %w", param.Name, err)
+				}
 
-  for fieldName, slice := range fieldValuesMap {
-    frame.Fields = append(frame.Fields, data.NewField(fieldName, nil, slice))
-  }
+				value = string(vector)
+			}
 
-  response.Frames = append(response.Frames, frame)
+			args = append(args, strings.TrimPrefix(param.Name, "$"), value)
+		}
+	}
 
-  return response
+	// Parameters require dialect 2
+	dialect := qm.Dialect
+	if dialect == 0 && len(qm.SearchParams) > 0 {
+		dialect = 2
+	}
+
+	if dialect > 0 {
+		args = append(args, "DIALECT", strconv.Itoa(dialect))
+	}
+
+	return args, nil
+}
+
+/**
+ * Vector blob from the array of floats, i.e. [0.1, 0.2] or 0.1,0.2, or base64 string
+ */
+func ftVector(value string, vectorType string) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	floats := strings.FieldsFunc(strings.Trim(value, "[]"), func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+
+	// Base64 encoded blob
+	if len(floats) == 1 && !strings.HasPrefix(value, "[") {
+		if _, err := strconv.ParseFloat(floats[0], 64); err != nil {
+			return base64.StdEncoding.DecodeString(value)
+		}
+	}
+
+	if len(floats) == 0 {
+		return nil, errors.New("vector is empty")
+	}
+
+	buf := new(bytes.Buffer)
+	for _, f := range floats {
+		number, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		// Little-endian as expected by RediSearch
+		if vectorType == models.SearchParamFloat64 {
+			err = binary.Write(buf, binary.LittleEndian, number)
+		} else {
+			err = binary.Write(buf, binary.LittleEndian, float32(number))
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+/**
+ * Index attribute from FT.INFO
+ */
+type ftAttribute struct {
+	identifier string
+	name       string
+	fieldType  string
+	options    []string
+}
+
+/**
+ * Index attributes from FT.INFO, fields are returned by RediSearch 1.x
+ */
+func ftAttributes(info map[string]interface{}) []ftAttribute {
+	values, ok := info["attributes"].([]interface{})
+	if !ok {
+		values, _ = info["fields"].([]interface{})
+	}
+
+	var attributes []ftAttribute
+	for _, value := range values {
+		properties, ok := value.([]interface{})
+		if !ok {
+			continue
+		}
+
+		attribute := ftAttribute{}
+		for i := 0; i < len(properties); i++ {
+			property := replyToString(properties[i])
+
+			switch strings.ToLower(property) {
+			case "identifier", "attribute", "type":
+				if i+1 >= len(properties) {
+					continue
+				}
+
+				i++
+				switch strings.ToLower(property) {
+				case "identifier":
+					attribute.identifier = replyToString(properties[i])
+				case "attribute":
+					attribute.name = replyToString(properties[i])
+				case "type":
+					attribute.fieldType = strings.ToUpper(replyToString(properties[i]))
+				}
+			default:
+				// Name is the first element in RediSearch 1.x
+				if i == 0 {
+					attribute.identifier = property
+					attribute.name = property
+					continue
+				}
+
+				attribute.options = append(attribute.options, property)
+			}
+		}
+
+		if attribute.name == "" {
+			attribute.name = attribute.identifier
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	return attributes
 }
 
 /**
@@ -83,53 +471,341 @@ func queryFtSearch(qm queryModel, client redisClient) backend.DataResponse {
  * @see https://oss.redislabs.com/redisearch/Commands/#ftinfo
  */
 func queryFtInfo(qm queryModel, client redisClient) backend.DataResponse {
-  response := backend.DataResponse{}
-
-  // Execute command
-  var result map[string]interface{}
-  err := client.RunCmd(&result, qm.Command, qm.Key)
-
-  // Check error
-  if err != nil {
-    return errorHandler(response, err)
-  }
-
-  // Create data frame response
-  frame := data.NewFrame(qm.Key)
-
-  // Add fields and values
-  for key := range result {
-    // Value
-    switch value := result[key].(type) {
-    case int64:
-      // Add field
-      field := data.NewField(key, nil, []int64{value})
-      frame.Fields = append(frame.Fields, field)
-    case []byte:
-      // Parse Float
-      if floatValue, err := strconv.ParseFloat(string(value), 64); err == nil {
-        field := data.NewField(key, nil, []float64{floatValue})
-
-        // Set unit
-        if models.SearchInfoConfig[key] != "" {
-          field.Config = &data.FieldConfig{Unit: models.SearchInfoConfig[key]}
-        }
-
-        frame.Fields = append(frame.Fields, field)
-      } else {
-        frame.Fields = append(frame.Fields, data.NewField(key, nil, []string{string(value)}))
-      }
-    case string:
-      frame.Fields = append(frame.Fields, data.NewField(key, nil, []string{string(value)}))
-    case []interface{}:
-    default:
-      log.DefaultLogger.Error(models.SearchInfo, "Conversion Error", "Unsupported Value type")
-    }
-  }
-
-  // Add the frame to the response
-  response.Frames = append(response.Frames, frame)
-
-  // Return Response
-  return response
+	response := backend.DataResponse{}
+
+	// Execute command
+	var result map[string]interface{}
+	err := client.RunCmd(&result, qm.Command, qm.Key)
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// Create data frame response
+	frame := data.NewFrame(qm.Key)
+
+	// Add fields and values
+	for key := range result {
+		// Value
+		switch value := result[key].(type) {
+		case int64:
+			// Add field
+			field := data.NewField(key, nil, []int64{value})
+			frame.Fields = append(frame.Fields, field)
+		case []byte:
+			// Parse Float
+			if floatValue, err := strconv.ParseFloat(string(value), 64); err == nil {
+				field := data.NewField(key, nil, []float64{floatValue})
+
+				// Set unit
+				if models.SearchInfoConfig[key] != "" {
+					field.Config = &data.FieldConfig{Unit: models.SearchInfoConfig[key]}
+				}
+
+				frame.Fields = append(frame.Fields, field)
+			} else {
+				frame.Fields = append(frame.Fields, data.NewField(key, nil, []string{string(value)}))
+			}
+		case string:
+			frame.Fields = append(frame.Fields, data.NewField(key, nil, []string{string(value)}))
+		case []interface{}:
+			// Nested values are returned as separate frames
+		default:
+			log.DefaultLogger.Error(models.SearchInfo, "Conversion Error", "Unsupported Value type")
+		}
+	}
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, frame)
+
+	// Index schema
+	if attributes := ftAttributes(result); len(attributes) > 0 {
+		schema := data.NewFrame("attributes",
+			data.NewField("Identifier", nil, []string{}),
+			data.NewField("Attribute", nil, []string{}),
+			data.NewField("Type", nil, []string{}),
+			data.NewField("Options", nil, []string{}))
+
+		for _, attribute := range attributes {
+			schema.AppendRow(attribute.identifier, attribute.name, attribute.fieldType, strings.Join(attribute.options, " "))
+		}
+
+		response.Frames = append(response.Frames, schema)
+	}
+
+	// Statistics
+	for _, name := range models.SearchInfoStats {
+		if values, ok := result[name].([]interface{}); ok && len(values) > 0 {
+			response.Frames = append(response.Frames, createFtStatsFrame(name, values))
+		}
+	}
+
+	// Return Response
+	return response
+}
+
+/**
+ * FT._LIST
+ *
+ * Returns overview of all indexes using pipelined FT.INFO, failing index is returned with the error in the status.
+ *
+ * @see https://redis.io/commands/ft._list
+ */
+func queryFtList(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Execute command
+	var indexes []string
+	err := client.RunCmd(&indexes, qm.Command)
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	sort.Strings(indexes)
+
+	// Pipeline FT.INFO commands
+	infos := make([]map[string]interface{}, len(indexes))
+	var commands []flatCommandArgs
+	for i, index := range indexes {
+		commands = append(commands, flatCommandArgs{cmd: models.SearchInfo, key: index, rcv: &infos[i]})
+	}
+
+	// Status of each index, error is returned for the dropped or not allowed by ACL index
+	statuses := make([]string, len(indexes))
+	for i := range statuses {
+		statuses[i] = "OK"
+	}
+
+	if len(commands) > 0 {
+		// Pipeline fails on the first error, run commands separately to find failing indexes
+		if err := client.RunBatchFlatCmd(commands); err != nil {
+			for i, index := range indexes {
+				infos[i] = nil
+				if err := client.RunCmd(&infos[i], models.SearchInfo, index); err != nil {
+					statuses[i] = err.Error()
+				}
+			}
+		}
+	}
+
+	// Create data frame response
+	frame := data.NewFrame(qm.Command, data.NewField("index_name", nil, indexes), data.NewField("status", nil, statuses))
+	for _, name := range models.SearchListFields {
+		field := data.NewField(name, nil, make([]*float64, len(indexes)))
+
+		// Set unit
+		if models.SearchInfoConfig[name] != "" {
+			field.Config = &data.FieldConfig{Unit: models.SearchInfoConfig[name]}
+		}
+
+		frame.Fields = append(frame.Fields, field)
+	}
+
+	for i, info := range infos {
+		// Indexing failures are returned in the Index Errors since RediSearch 2.8
+		if indexErrors, ok := info["Index Errors"].([]interface{}); ok {
+			for j := 0; j+1 < len(indexErrors); j += 2 {
+				if replyToString(indexErrors[j]) == "indexing failures" {
+					info["indexing_failures"] = indexErrors[j+1]
+				}
+			}
+		}
+
+		for j, name := range models.SearchListFields {
+			if value, err := strconv.ParseFloat(replyToString(info[name]), 64); err == nil {
+				frame.Fields[j+2].Set(i, &value)
+			}
+		}
+	}
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, frame)
+
+	// Return Response
+	return response
+}
+
+/**
+ * FT.TAGVALS {index} {field}
+ *
+ * Returns the distinct tags indexed in a Tag field.
+ *
+ * @see https://redis.io/commands/ft.tagvals
+ */
+func queryFtTagVals(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Execute command
+	var values []string
+	err := client.RunCmd(&values, qm.Command, qm.Key, strings.TrimPrefix(qm.Field, "@"))
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	sort.Strings(values)
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, data.NewFrame(qm.Command, data.NewField("Value", nil, values)))
+
+	// Return Response
+	return response
+}
+
+/**
+ * FT.SUGGET {key} {prefix} [FUZZY] WITHSCORES [MAX]
+ *
+ * Returns completion suggestions for a prefix with scores.
+ *
+ * @see https://redis.io/commands/ft.sugget
+ */
+func queryFtSugGet(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	args := []string{qm.Key, qm.Prefix}
+	if qm.Fuzzy {
+		args = append(args, "FUZZY")
+	}
+
+	args = append(args, "WITHSCORES")
+	if qm.Count > 0 {
+		args = append(args, "MAX", strconv.Itoa(qm.Count))
+	}
+
+	// Execute command
+	var values []string
+	err := client.RunCmd(&values, qm.Command, args...)
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// Create data frame response
+	frame := data.NewFrame(qm.Command,
+		data.NewField("Suggestion", nil, []string{}),
+		data.NewField("Score", nil, []float64{}))
+
+	for i := 0; i+1 < len(values); i += 2 {
+		score, _ := strconv.ParseFloat(values[i+1], 64)
+		frame.AppendRow(values[i], score)
+	}
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, frame)
+
+	// Return Response
+	return response
+}
+
+/**
+ * FT.SPELLCHECK {index} {query} [DISTANCE] [TERMS INCLUDE|EXCLUDE {dict}] [DIALECT]
+ *
+ * Returns suggestions for misspelled terms in a query.
+ *
+ * @see https://redis.io/commands/ft.spellcheck
+ */
+func queryFtSpellCheck(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	args := []string{qm.Key, qm.SearchQuery}
+	if qm.Distance > 0 {
+		args = append(args, "DISTANCE", strconv.Itoa(qm.Distance))
+	}
+
+	if qm.IncludeTerms != "" {
+		args = append(args, "TERMS", "INCLUDE", qm.IncludeTerms)
+	}
+
+	if qm.ExcludeTerms != "" {
+		args = append(args, "TERMS", "EXCLUDE", qm.ExcludeTerms)
+	}
+
+	if qm.Dialect > 0 {
+		args = append(args, "DIALECT", strconv.Itoa(qm.Dialect))
+	}
+
+	// Execute command
+	var result []interface{}
+	err := client.RunCmd(&result, qm.Command, args...)
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// Create data frame response
+	frame := data.NewFrame(qm.Command,
+		data.NewField("Term", nil, []string{}),
+		data.NewField("Suggestion", nil, []*string{}),
+		data.NewField("Score", nil, []*float64{}))
+
+	// Each term is returned as TERM, term and list of scores with suggestions
+	for _, value := range result {
+		term, ok := value.([]interface{})
+		if !ok || len(term) < 3 {
+			continue
+		}
+
+		suggestions, _ := term[2].([]interface{})
+		if len(suggestions) == 0 {
+			frame.AppendRow(replyToString(term[1]), nil, nil)
+			continue
+		}
+
+		for _, suggestion := range suggestions {
+			pair, ok := suggestion.([]interface{})
+			if !ok || len(pair) < 2 {
+				continue
+			}
+
+			text := replyToString(pair[1])
+			score, _ := strconv.ParseFloat(replyToString(pair[0]), 64)
+			frame.AppendRow(replyToString(term[1]), &text, &score)
+		}
+	}
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, frame)
+
+	// Return Response
+	return response
+}
+
+/**
+ * Statistics from the list of names and values, numbers are returned as float64
+ */
+func createFtStatsFrame(name string, values []interface{}) *data.Frame {
+	frame := data.NewFrame(name)
+
+	for i := 0; i+1 < len(values); i += 2 {
+		key := replyToString(values[i])
+		value := replyToString(values[i+1])
+
+		// RediSearch returns -nan before the first run
+		number, err := strconv.ParseFloat(value, 64)
+		if strings.EqualFold(value, "-nan") {
+			number, err = math.NaN(), nil
+		}
+
+		if err != nil {
+			frame.Fields = append(frame.Fields, data.NewField(key, nil, []string{value}))
+			continue
+		}
+
+		field := data.NewField(key, nil, []float64{number})
+
+		// Set unit
+		if models.SearchInfoConfig[key] != "" {
+			field.Config = &data.FieldConfig{Unit: models.SearchInfoConfig[key]}
+		}
+
+		frame.Fields = append(frame.Fields, field)
+	}
+
+	return frame
 }
@@ -3,7 +3,9 @@ package main
 import (
 	"errors"
 	"fmt"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
 	"github.com/stretchr/testify/require"
@@ -12,6 +14,17 @@ import (
 func TestQueryFtSearch(t *testing.T) {
 	t.Parallel()
 
+	str := func(value string) *string { return &value }
+	num := func(value float64) *float64 { return &value }
+
+	schemaRcv := map[string]interface{}{
+		"index_name": []byte("test"),
+		"attributes": []interface{}{
+			[]interface{}{[]byte("identifier"), []byte("name"), []byte("attribute"), []byte("name"), []byte("type"), []byte("TEXT"), []byte("WEIGHT"), []byte("1")},
+			[]interface{}{[]byte("identifier"), []byte("age"), []byte("attribute"), []byte("age"), []byte("type"), []byte("NUMERIC"), []byte("SORTABLE")},
+		},
+	}
+
 	commonHashRcv := []interface{}{
 		make([]uint8, 1),
 		[]uint8("test:1"),
@@ -63,30 +76,30 @@ func TestQueryFtSearch(t *testing.T) {
 	}
 
 	commonHashCheck := []valueToCheckByLabelInResponse{
-		{frameIndex: 0, fieldName: "key_name", rowIndex: 0, value: "test:1"},
-		{frameIndex: 0, fieldName: "name", rowIndex: 0, value: "steve"},
-		{frameIndex: 0, fieldName: "age", rowIndex: 0, value: "34"},
+		{frameIndex: 0, fieldName: "keyName", rowIndex: 0, value: "test:1"},
+		{frameIndex: 0, fieldName: "name", rowIndex: 0, value: str("steve")},
+		{frameIndex: 0, fieldName: "age", rowIndex: 0, value: num(34)},
 	}
 
 	multiHashCheck := []valueToCheckByLabelInResponse{
-		{frameIndex: 0, fieldName: "key_name", rowIndex: 0, value: "test:1"},
-		{frameIndex: 0, fieldName: "name", rowIndex: 0, value: "steve"},
-		{frameIndex: 0, fieldName: "age", rowIndex: 0, value: "34"},
-		{frameIndex: 0, fieldName: "key_name", rowIndex: 1, value: "test:2"},
-		{frameIndex: 0, fieldName: "name", rowIndex: 1, value: "foo"},
-		{frameIndex: 0, fieldName: "age", rowIndex: 1, value: "38"},
+		{frameIndex: 0, fieldName: "keyName", rowIndex: 0, value: "test:1"},
+		{frameIndex: 0, fieldName: "name", rowIndex: 0, value: str("steve")},
+		{frameIndex: 0, fieldName: "age", rowIndex: 0, value: num(34)},
+		{frameIndex: 0, fieldName: "keyName", rowIndex: 1, value: "test:2"},
+		{frameIndex: 0, fieldName: "name", rowIndex: 1, value: str("foo")},
+		{frameIndex: 0, fieldName: "age", rowIndex: 1, value: num(38)},
 	}
 
 	sparseHashCheck := []valueToCheckByLabelInResponse{
-		{frameIndex: 0, fieldName: "key_name", rowIndex: 0, value: "test:1"},
-		{frameIndex: 0, fieldName: "name", rowIndex: 0, value: ""},
-		{frameIndex: 0, fieldName: "age", rowIndex: 0, value: "34"},
-		{frameIndex: 0, fieldName: "key_name", rowIndex: 1, value: "test:2"},
-		{frameIndex: 0, fieldName: "name", rowIndex: 1, value: "foo"},
-		{frameIndex: 0, fieldName: "age", rowIndex: 1, value: "38"},
-		{frameIndex: 0, fieldName: "key_name", rowIndex: 2, value: "test:3"},
-		{frameIndex: 0, fieldName: "name", rowIndex: 2, value: "baz"},
-		{frameIndex: 0, fieldName: "age", rowIndex: 2, value: ""},
+		{frameIndex: 0, fieldName: "keyName", rowIndex: 0, value: "test:1"},
+		{frameIndex: 0, fieldName: "name", rowIndex: 0, value: (*string)(nil)},
+		{frameIndex: 0, fieldName: "age", rowIndex: 0, value: num(34)},
+		{frameIndex: 0, fieldName: "keyName", rowIndex: 1, value: "test:2"},
+		{frameIndex: 0, fieldName: "name", rowIndex: 1, value: str("foo")},
+		{frameIndex: 0, fieldName: "age", rowIndex: 1, value: num(38)},
+		{frameIndex: 0, fieldName: "keyName", rowIndex: 2, value: "test:3"},
+		{frameIndex: 0, fieldName: "name", rowIndex: 2, value: str("baz")},
+		{frameIndex: 0, fieldName: "age", rowIndex: 2, value: (*float64)(nil)},
 	}
 
 	tests := []struct {
@@ -192,9 +205,9 @@ func TestQueryFtSearch(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			client := testClient{rcv: tt.rcv, err: tt.err, expectedArgs: tt.expectedArgs, expectedCmd: tt.expectedCmd}
+			client := testClient{rcv: tt.rcv, cmdRcv: map[string]interface{}{models.SearchInfo: schemaRcv}, err: tt.err, expectedArgs: tt.expectedArgs, expectedCmd: tt.expectedCmd}
 
-			response := queryFtSearch(tt.qm, &client)
+			response := queryFtSearch(0, 0, tt.qm, &client)
 
 			if tt.err != nil {
 				require.EqualError(t, response.Error, tt.err.Error(), "Should set error to response if failed")
@@ -213,6 +226,315 @@ func TestQueryFtSearch(t *testing.T) {
 	}
 }
 
+/**
+ * FT.SEARCH typed fields
+ */
+func TestQueryFtSearchTypes(t *testing.T) {
+	t.Parallel()
+
+	// RediSearch 1.x schema
+	schemaRcv := map[string]interface{}{
+		"fields": []interface{}{
+			[]interface{}{[]byte("title"), []byte("type"), []byte("TEXT"), []byte("WEIGHT"), []byte("1")},
+			[]interface{}{[]byte("price"), []byte("type"), []byte("NUMERIC")},
+			[]interface{}{[]byte("created"), []byte("type"), []byte("NUMERIC"), []byte("SORTABLE")},
+		},
+	}
+
+	searchRcv := []interface{}{
+		int64(2),
+		[]byte("doc:1"),
+		[]interface{}{[]byte("extra"), []byte("x"), []byte("created"), []byte("1600000000"), []byte("price"), []byte("9.5"), []byte("title"), []byte("foo")},
+		[]byte("doc:2"),
+		[]interface{}{[]byte("title"), []byte("bar")},
+	}
+
+	t.Run("should order columns by schema and set types", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{rcv: searchRcv, cmdRcv: map[string]interface{}{models.SearchInfo: schemaRcv}}
+		response := queryFtSearch(0, 0, queryModel{Command: models.Search, Key: "idx", SearchTimeField: "@created", SearchTimeUnit: models.SearchTimeUnitSeconds}, &client)
+		require.NoError(t, response.Error)
+
+		frame := response.Frames[0]
+		require.Len(t, frame.Fields, 5)
+		require.Equal(t, []string{"keyName", "title", "price", "created", "extra"}, []string{frame.Fields[0].Name, frame.Fields[1].Name, frame.Fields[2].Name, frame.Fields[3].Name, frame.Fields[4].Name})
+		require.Equal(t, "doc:2", frame.Fields[0].At(1))
+		require.Equal(t, "bar", *frame.Fields[1].At(1).(*string))
+		require.Equal(t, 9.5, *frame.Fields[2].At(0).(*float64))
+		require.Nil(t, frame.Fields[2].At(1).(*float64))
+		require.Equal(t, time.Unix(1600000000, 0), *frame.Fields[3].At(0).(*time.Time))
+		require.Nil(t, frame.Fields[3].At(1).(*time.Time))
+		require.Nil(t, frame.Fields[4].At(1).(*string))
+	})
+
+	t.Run("should return strings if schema is not available", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{rcv: searchRcv, cmdRcv: map[string]interface{}{models.SearchInfo: errors.New("NOPERM this user has no permissions to run the 'ft.info' command")}}
+		response := queryFtSearch(0, 0, queryModel{Command: models.Search, Key: "idx"}, &client)
+		require.NoError(t, response.Error)
+
+		frame := response.Frames[0]
+		require.Equal(t, []string{"keyName", "extra", "created", "price", "title"}, []string{frame.Fields[0].Name, frame.Fields[1].Name, frame.Fields[2].Name, frame.Fields[3].Name, frame.Fields[4].Name})
+		require.Equal(t, "9.5", *frame.Fields[3].At(0).(*string))
+	})
+
+	t.Run("should order columns by return fields", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{rcv: searchRcv, cmdRcv: map[string]interface{}{models.SearchInfo: schemaRcv}}
+		response := queryFtSearch(0, 0, queryModel{Command: models.Search, Key: "idx", ReturnFields: []string{"price", "title"}}, &client)
+		require.NoError(t, response.Error)
+
+		frame := response.Frames[0]
+		require.Equal(t, "price", frame.Fields[1].Name)
+		require.Equal(t, "title", frame.Fields[2].Name)
+		require.Equal(t, "created", frame.Fields[3].Name)
+		require.Equal(t, 1600000000.0, *frame.Fields[3].At(0).(*float64))
+	})
+
+	t.Run("should filter by time range", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			qm           queryModel
+			expectedArgs []string
+		}{
+			{
+				queryModel{Command: models.Search, Key: "idx", SearchQuery: "@title:foo", SearchTimeField: "created", SearchTimeUnit: models.SearchTimeUnitSeconds, SearchTimeFilter: true},
+				[]string{"idx", "(@title:foo) @created:[1599999999 1600000061]"},
+			},
+			{
+				queryModel{Command: models.Search, Key: "idx", SearchTimeField: "@created", SearchTimeUnit: models.SearchTimeUnitMilliseconds, SearchTimeFilter: true},
+				[]string{"idx", "@created:[1599999999500 1600000060500]"},
+			},
+			{
+				queryModel{Command: models.Search, Key: "idx", SearchQuery: "@title:foo", SearchTimeField: "created", SearchTimeUnit: models.SearchTimeUnitSeconds},
+				[]string{"idx", "@title:foo"},
+			},
+		}
+
+		for _, tt := range tests {
+			client := testClient{rcv: searchRcv, cmdRcv: map[string]interface{}{models.SearchInfo: schemaRcv}, expectedArgs: tt.expectedArgs}
+			response := queryFtSearch(1599999999500, 1600000060500, tt.qm, &client)
+			require.NoError(t, response.Error)
+		}
+	})
+}
+
+/**
+ * FT.SEARCH scores, highlighting and summarization
+ */
+func TestQueryFtSearchOptions(t *testing.T) {
+	t.Parallel()
+
+	schemaRcv := map[string]interface{}{
+		"attributes": []interface{}{
+			[]interface{}{[]byte("identifier"), []byte("title"), []byte("attribute"), []byte("title"), []byte("type"), []byte("TEXT")},
+		},
+	}
+
+	t.Run("should return scores and total", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv: []interface{}{
+				int64(25),
+				[]byte("doc:1"), []byte("2.5"), []interface{}{[]byte("title"), []byte("<b>foo</b> bar")},
+				[]byte("doc:2"), []byte("1"), []interface{}{[]byte("title"), []byte("<b>foo</b>...")},
+			},
+			cmdRcv:      map[string]interface{}{models.SearchInfo: schemaRcv},
+			expectedCmd: models.Search,
+			expectedArgs: []string{
+				"idx", "foo", "VERBATIM", "WITHSCORES",
+				"INKEYS", "2", "doc:1", "doc:2",
+				"INFIELDS", "1", "title",
+				"SUMMARIZE", "FIELDS", "1", "title", "FRAGS", "2", "LEN", "10", "SEPARATOR", "...",
+				"HIGHLIGHT", "FIELDS", "1", "title", "TAGS", "<b>", "</b>",
+				"LIMIT", "0", "2",
+			},
+		}
+
+		qm := queryModel{
+			Command: models.Search, Key: "idx", SearchQuery: "foo", Count: 2,
+			Verbatim: true, WithScores: true, InKeys: []string{"doc:1", "doc:2"}, InFields: []string{"title"},
+			Summarize: true, SummarizeFields: []string{"title"}, SummarizeFrags: 2, SummarizeLen: 10, SummarizeSeparator: "...",
+			Highlight: true, HighlightFields: []string{"title"}, HighlightOpen: "<b>", HighlightClose: "</b>",
+		}
+
+		response := queryFtSearch(0, 0, qm, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 2)
+
+		frame := response.Frames[0]
+		require.Equal(t, "keyName", frame.Fields[0].Name)
+		require.Equal(t, "score", frame.Fields[1].Name)
+		require.Equal(t, 2.5, frame.Fields[1].At(0))
+		require.Equal(t, 1.0, frame.Fields[1].At(1))
+		require.Equal(t, "<b>foo</b> bar", *frame.Fields[2].At(0).(*string))
+		require.Equal(t, 25.0, frame.Meta.Stats[0].Value)
+
+		require.Equal(t, "Cursor", response.Frames[1].Name)
+		require.Equal(t, "2", response.Frames[1].Fields[0].At(0))
+		require.Len(t, frame.Meta.Notices, 1)
+	})
+
+	t.Run("should return keys without content", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:          []interface{}{int64(2), []byte("doc:1"), []byte("0.5"), []byte("doc:2"), []byte("0.25")},
+			expectedCmd:  models.Search,
+			expectedArgs: []string{"idx", "*", "NOCONTENT", "WITHSCORES"},
+		}
+
+		response := queryFtSearch(0, 0, queryModel{Command: models.Search, Key: "idx", NoContent: true, WithScores: true, ReturnFields: []string{"title"}}, &client)
+		require.NoError(t, response.Error)
+
+		frame := response.Frames[0]
+		require.Len(t, frame.Fields, 2)
+		require.Equal(t, "doc:2", frame.Fields[0].At(1))
+		require.Equal(t, 0.25, frame.Fields[1].At(1))
+		require.Equal(t, 2.0, frame.Meta.Stats[0].Value)
+		require.Len(t, response.Frames, 1, "Cursor should not be returned without paging")
+	})
+}
+
+/**
+ * FT.SEARCH pagination
+ */
+func TestQueryFtSearchCursor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		qm           queryModel
+		rcv          interface{}
+		expectedArgs []string
+		cursor       string
+		count        int64
+		err          string
+	}{
+		{
+			"should read next page",
+			queryModel{Command: models.Search, Key: "idx", NoContent: true, Offset: 5, Count: 2, Cursor: "2"},
+			[]interface{}{int64(5), []byte("doc:3"), []byte("doc:4")},
+			[]string{"idx", "*", "NOCONTENT", "LIMIT", "2", "2"},
+			"4",
+			2,
+			"",
+		},
+		{
+			"should read last page",
+			queryModel{Command: models.Search, Key: "idx", NoContent: true, Count: 2, Cursor: "4"},
+			[]interface{}{int64(5), []byte("doc:5")},
+			[]string{"idx", "*", "NOCONTENT", "LIMIT", "4", "2"},
+			"0",
+			1,
+			"",
+		},
+		{
+			"should validate cursor",
+			queryModel{Command: models.Search, Key: "idx", Cursor: "next"},
+			nil,
+			nil,
+			"",
+			0,
+			"cursor is not valid: next",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := testClient{rcv: tt.rcv, expectedCmd: models.Search, expectedArgs: tt.expectedArgs}
+			response := queryFtSearch(0, 0, tt.qm, &client)
+
+			if tt.err != "" {
+				require.EqualError(t, response.Error, tt.err)
+				return
+			}
+
+			require.NoError(t, response.Error)
+			require.Len(t, response.Frames, 2)
+			require.Equal(t, tt.cursor, response.Frames[1].Fields[0].At(0))
+			require.Equal(t, tt.count, response.Frames[1].Fields[1].At(0))
+		})
+	}
+}
+
+/**
+ * FT.SEARCH vector similarity
+ */
+func TestQueryFtSearchKnn(t *testing.T) {
+	t.Parallel()
+
+	client := testClient{
+		rcv: []interface{}{
+			int64(1),
+			[]byte("doc:1"),
+			[]interface{}{[]byte("__embedding_score"), []byte("0.25"), []byte("title"), []byte("foo")},
+		},
+		cmdRcv:       map[string]interface{}{models.SearchInfo: map[string]interface{}{}},
+		expectedCmd:  models.Search,
+		expectedArgs: []string{"idx", "*=>[KNN 10 @embedding $vec]", "PARAMS", "2", "vec", string([]byte{0, 0, 128, 63, 0, 0, 0, 64}), "DIALECT", "2"},
+	}
+
+	qm := queryModel{Command: models.Search, Key: "idx", SearchQuery: "*=>[KNN 10 @embedding $vec]", SearchParams: []searchParam{
+		{Name: "$vec", Type: models.SearchParamFloat32, Value: "[1, 2]"},
+	}}
+
+	response := queryFtSearch(0, 0, qm, &client)
+	require.NoError(t, response.Error)
+
+	frame := response.Frames[0]
+	require.Equal(t, "__embedding_score", frame.Fields[1].Name)
+	require.Equal(t, 0.25, *frame.Fields[1].At(0).(*float64))
+	require.Equal(t, "foo", *frame.Fields[2].At(0).(*string))
+}
+
+/**
+ * PARAMS and DIALECT
+ */
+func TestFtParamsArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		qm       queryModel
+		expected []string
+		err      string
+	}{
+		{"no parameters", queryModel{}, nil, ""},
+		{"dialect", queryModel{Dialect: 3}, []string{"DIALECT", "3"}, ""},
+		{"text parameter", queryModel{SearchParams: []searchParam{{Name: "min", Value: "10"}}, Dialect: 4}, []string{"PARAMS", "2", "min", "10", "DIALECT", "4"}, ""},
+		{"float32 vector", queryModel{SearchParams: []searchParam{{Name: "vec", Type: models.SearchParamFloat32, Value: "1,2"}}}, []string{"PARAMS", "2", "vec", string([]byte{0, 0, 128, 63, 0, 0, 0, 64}), "DIALECT", "2"}, ""},
+		{"float64 vector", queryModel{SearchParams: []searchParam{{Name: "vec", Type: models.SearchParamFloat64, Value: "[1]"}}}, []string{"PARAMS", "2", "vec", string([]byte{0, 0, 0, 0, 0, 0, 240, 63}), "DIALECT", "2"}, ""},
+		{"base64 vector", queryModel{SearchParams: []searchParam{{Name: "vec", Type: models.SearchParamFloat32, Value: "AACAPwAAAEA="}}}, []string{"PARAMS", "2", "vec", string([]byte{0, 0, 128, 63, 0, 0, 0, 64}), "DIALECT", "2"}, ""},
+		{"invalid vector", queryModel{SearchParams: []searchParam{{Name: "vec", Type: models.SearchParamFloat32, Value: "[1, a]"}}}, nil, "parameter vec is not valid vector: strconv.ParseFloat: parsing \"a\": invalid syntax"},
+		{"missing name", queryModel{SearchParams: []searchParam{{Value: "1"}}}, nil, "name is required for the query parameter"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args, err := ftParamsArgs(tt.qm)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, args)
+		})
+	}
+}
+
 /**
  * FT.INFO
  */
@@ -380,3 +702,254 @@ func TestQueryFtInfo(t *testing.T) {
 		})
 	}
 }
+
+/**
+ * FT.INFO nested values
+ */
+func TestQueryFtInfoNested(t *testing.T) {
+	t.Parallel()
+
+	client := testClient{rcv: map[string]interface{}{
+		"index_name": []byte("idx"),
+		"num_docs":   []byte("10"),
+		"attributes": []interface{}{
+			[]interface{}{[]byte("identifier"), []byte("$.title"), []byte("attribute"), []byte("title"), []byte("type"), []byte("TEXT"), []byte("WEIGHT"), []byte("1"), []byte("SORTABLE")},
+			[]interface{}{[]byte("identifier"), []byte("$.tags"), []byte("attribute"), []byte("tags"), []byte("type"), []byte("TAG"), []byte("SEPARATOR"), []byte(",")},
+		},
+		"gc_stats": []interface{}{
+			[]byte("bytes_collected"), []byte("4148136"),
+			[]byte("total_ms_run"), []byte("14796"),
+			[]byte("average_cycle_time_ms"), []byte("-nan"),
+		},
+		"cursor_stats": []interface{}{
+			[]byte("global_idle"), int64(0),
+			[]byte("global_total"), int64(2),
+		},
+		"dialect_stats": []interface{}{
+			[]byte("dialect_1"), int64(5),
+			[]byte("dialect_2"), int64(1),
+		},
+	}}
+
+	response := queryFtInfo(queryModel{Command: models.SearchInfo, Key: "idx"}, &client)
+	require.NoError(t, response.Error)
+	require.Len(t, response.Frames, 5)
+
+	// Schema
+	schema := response.Frames[1]
+	require.Equal(t, "attributes", schema.Name)
+	require.Equal(t, 2, schema.Rows())
+	require.Equal(t, "$.title", schema.Fields[0].At(0))
+	require.Equal(t, "title", schema.Fields[1].At(0))
+	require.Equal(t, "TEXT", schema.Fields[2].At(0))
+	require.Equal(t, "WEIGHT 1 SORTABLE", schema.Fields[3].At(0))
+	require.Equal(t, "SEPARATOR ,", schema.Fields[3].At(1))
+
+	// GC
+	gc := response.Frames[2]
+	require.Equal(t, "gc_stats", gc.Name)
+	require.Equal(t, 4148136.0, gc.Fields[0].At(0))
+	require.Equal(t, "decbytes", gc.Fields[0].Config.Unit)
+	require.Equal(t, "ms", gc.Fields[1].Config.Unit)
+	require.True(t, math.IsNaN(gc.Fields[2].At(0).(float64)))
+
+	// Cursors
+	require.Equal(t, "cursor_stats", response.Frames[3].Name)
+	require.Equal(t, 2.0, response.Frames[3].Fields[1].At(0))
+
+	// Dialects
+	require.Equal(t, "dialect_stats", response.Frames[4].Name)
+	require.Equal(t, "dialect_1", response.Frames[4].Fields[0].Name)
+	require.Equal(t, 5.0, response.Frames[4].Fields[0].At(0))
+}
+
+/**
+ * FT._LIST
+ */
+func TestQueryFtList(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return overview of indexes", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:         []string{"products", "events"},
+			expectedCmd: models.SearchList,
+			batchRcv: [][]interface{}{{
+				map[string]interface{}{
+					"index_name":             []byte("events"),
+					"num_docs":               int64(100),
+					"inverted_sz_mb":         []byte("0.5"),
+					"percent_indexed":        []byte("1"),
+					"hash_indexing_failures": int64(3),
+					"Index Errors":           []interface{}{[]byte("indexing failures"), int64(3), []byte("last indexing error"), []byte("N/A")},
+				},
+				map[string]interface{}{
+					"index_name":             []byte("products"),
+					"num_docs":               []byte("20"),
+					"percent_indexed":        []byte("0.5"),
+					"hash_indexing_failures": []byte("0"),
+				},
+			}},
+		}
+
+		response := queryFtList(queryModel{Command: models.SearchList}, &client)
+		require.NoError(t, response.Error)
+
+		frame := response.Frames[0]
+		require.Equal(t, 2, frame.Rows())
+		require.Equal(t, "index_name", frame.Fields[0].Name)
+		require.Equal(t, "events", frame.Fields[0].At(0))
+		require.Equal(t, "products", frame.Fields[0].At(1))
+		require.Equal(t, "OK", frame.Fields[1].At(0))
+
+		fields := map[string]int{}
+		for i, field := range frame.Fields {
+			fields[field.Name] = i
+		}
+
+		require.Equal(t, 100.0, *frame.Fields[fields["num_docs"]].At(0).(*float64))
+		require.Equal(t, 20.0, *frame.Fields[fields["num_docs"]].At(1).(*float64))
+		require.Equal(t, "decmbytes", frame.Fields[fields["inverted_sz_mb"]].Config.Unit)
+		require.Equal(t, 0.5, *frame.Fields[fields["inverted_sz_mb"]].At(0).(*float64))
+		require.Nil(t, frame.Fields[fields["inverted_sz_mb"]].At(1).(*float64))
+		require.Equal(t, 3.0, *frame.Fields[fields["indexing_failures"]].At(0).(*float64))
+		require.Nil(t, frame.Fields[fields["indexing_failures"]].At(1).(*float64))
+		require.Equal(t, 0.0, *frame.Fields[fields["hash_indexing_failures"]].At(1).(*float64))
+	})
+
+	t.Run("should return status of failing index", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:      []string{"products", "events"},
+			batchRcv: [][]interface{}{{map[string]interface{}{}, map[string]interface{}{}}},
+			batchErr: []error{errors.New("Unknown Index name")},
+			cmdRcv: map[string]interface{}{
+				models.SearchInfo + " events":   errors.New("Unknown Index name"),
+				models.SearchInfo + " products": map[string]interface{}{"num_docs": int64(20)},
+			},
+		}
+
+		response := queryFtList(queryModel{Command: models.SearchList}, &client)
+		require.NoError(t, response.Error)
+
+		frame := response.Frames[0]
+		require.Equal(t, 2, frame.Rows())
+		require.Equal(t, "status", frame.Fields[1].Name)
+		require.Equal(t, "Unknown Index name", frame.Fields[1].At(0))
+		require.Nil(t, frame.Fields[2].At(0).(*float64))
+		require.Equal(t, "OK", frame.Fields[1].At(1))
+		require.Equal(t, 20.0, *frame.Fields[2].At(1).(*float64))
+	})
+
+	t.Run("should handle error", func(t *testing.T) {
+		t.Parallel()
+
+		response := queryFtList(queryModel{Command: models.SearchList}, &testClient{err: errors.New("error occurred")})
+		require.EqualError(t, response.Error, "error occurred")
+	})
+}
+
+/**
+ * FT.TAGVALS
+ */
+func TestQueryFtTagVals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return sorted tag values", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{rcv: []string{"red", "blue"}, expectedCmd: models.SearchTagVals, expectedArgs: []string{"idx", "color"}}
+		response := queryFtTagVals(queryModel{Command: models.SearchTagVals, Key: "idx", Field: "@color"}, &client)
+		require.NoError(t, response.Error)
+		require.Equal(t, "Value", response.Frames[0].Fields[0].Name)
+		require.Equal(t, "blue", response.Frames[0].Fields[0].At(0))
+		require.Equal(t, "red", response.Frames[0].Fields[0].At(1))
+	})
+
+	t.Run("should handle error", func(t *testing.T) {
+		t.Parallel()
+
+		response := queryFtTagVals(queryModel{Command: models.SearchTagVals}, &testClient{err: errors.New("error occurred")})
+		require.EqualError(t, response.Error, "error occurred")
+	})
+}
+
+/**
+ * FT.SUGGET
+ */
+func TestQueryFtSugGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return suggestions with scores", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:          []string{"hello", "1.5", "help", "0.25"},
+			expectedCmd:  models.SearchSugGet,
+			expectedArgs: []string{"dict", "hel", "FUZZY", "WITHSCORES", "MAX", "2"},
+		}
+
+		response := queryFtSugGet(queryModel{Command: models.SearchSugGet, Key: "dict", Prefix: "hel", Fuzzy: true, Count: 2}, &client)
+		require.NoError(t, response.Error)
+
+		frame := response.Frames[0]
+		require.Equal(t, 2, frame.Rows())
+		require.Equal(t, "hello", frame.Fields[0].At(0))
+		require.Equal(t, 1.5, frame.Fields[1].At(0))
+		require.Equal(t, "help", frame.Fields[0].At(1))
+		require.Equal(t, 0.25, frame.Fields[1].At(1))
+	})
+
+	t.Run("should handle error", func(t *testing.T) {
+		t.Parallel()
+
+		response := queryFtSugGet(queryModel{Command: models.SearchSugGet}, &testClient{err: errors.New("error occurred")})
+		require.EqualError(t, response.Error, "error occurred")
+	})
+}
+
+/**
+ * FT.SPELLCHECK
+ */
+func TestQueryFtSpellCheck(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return suggestions for terms", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv: []interface{}{
+				[]interface{}{[]byte("TERM"), []byte("helo"), []interface{}{
+					[]interface{}{[]byte("0.5"), []byte("hello")},
+					[]interface{}{[]byte("0.25"), []byte("help")},
+				}},
+				[]interface{}{[]byte("TERM"), []byte("wrld"), []interface{}{}},
+			},
+			expectedCmd:  models.SearchSpellCheck,
+			expectedArgs: []string{"idx", "helo wrld", "DISTANCE", "2", "TERMS", "INCLUDE", "dict", "TERMS", "EXCLUDE", "stop", "DIALECT", "2"},
+		}
+
+		qm := queryModel{Command: models.SearchSpellCheck, Key: "idx", SearchQuery: "helo wrld", Distance: 2, IncludeTerms: "dict", ExcludeTerms: "stop", Dialect: 2}
+		response := queryFtSpellCheck(qm, &client)
+		require.NoError(t, response.Error)
+
+		frame := response.Frames[0]
+		require.Equal(t, 3, frame.Rows())
+		require.Equal(t, "helo", frame.Fields[0].At(0))
+		require.Equal(t, "hello", *frame.Fields[1].At(0).(*string))
+		require.Equal(t, 0.5, *frame.Fields[2].At(0).(*float64))
+		require.Equal(t, "help", *frame.Fields[1].At(1).(*string))
+		require.Equal(t, "wrld", frame.Fields[0].At(2))
+		require.Nil(t, frame.Fields[1].At(2).(*string))
+		require.Nil(t, frame.Fields[2].At(2).(*float64))
+	})
+
+	t.Run("should handle error", func(t *testing.T) {
+		t.Parallel()
+
+		response := queryFtSpellCheck(queryModel{Command: models.SearchSpellCheck}, &testClient{err: errors.New("error occurred")})
+		require.EqualError(t, response.Error, "error occurred")
+	})
+}
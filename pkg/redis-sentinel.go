@@ -0,0 +1,202 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/mediocregopher/radix/v3/resp/resp2"
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+)
+
+/**
+ * SENTINEL MASTERS
+ *
+ * @see https://redis.io/docs/management/sentinel/#sentinel-commands
+ */
+func querySentinelMasters(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Execute command
+	var result []interface{}
+	err := client.RunSentinelCmd(&result, "SENTINEL", "MASTERS")
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, createFrameFromSentinelEntries(qm.Command, result))
+
+	// Return
+	return response
+}
+
+/**
+ * SENTINEL REPLICAS <master name>
+ * SENTINEL SENTINELS <master name>
+ *
+ * @see https://redis.io/docs/management/sentinel/#sentinel-commands
+ */
+func querySentinelInstances(qm queryModel, client redisClient, subcommand string) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Master name is required
+	if qm.Key == "" {
+		return errorHandler(response, errors.New("master name is required"))
+	}
+
+	// Execute command
+	var result []interface{}
+	err := client.RunSentinelCmd(&result, "SENTINEL", subcommand, qm.Key)
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, createFrameFromSentinelEntries(qm.Command, result))
+
+	// Return
+	return response
+}
+
+/**
+ * SENTINEL CKQUORUM <master name>
+ *
+ * Check if the current Sentinel configuration is able to reach the quorum needed to failover a master,
+ * and the majority needed to authorize the failover.
+ * @see https://redis.io/docs/management/sentinel/#sentinel-commands
+ */
+func querySentinelCKQuorum(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Master name is required
+	if qm.Key == "" {
+		return errorHandler(response, errors.New("master name is required"))
+	}
+
+	// Execute command
+	var result string
+	err := client.RunSentinelCmd(&result, "SENTINEL", "CKQUORUM", qm.Key)
+
+	// Quorum can't be reached is replied as an error
+	var redisErr resp2.Error
+	if errors.As(err, &redisErr) && strings.HasPrefix(redisErr.E.Error(), "NOQUORUM") {
+		result = redisErr.E.Error()
+	} else if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// Status and message
+	status, message := result, ""
+	if fields := strings.SplitN(result, " ", 2); len(fields) == 2 {
+		status, message = fields[0], fields[1]
+	}
+
+	// Number of usable Sentinels
+	var usable int64
+	if fields := strings.Fields(message); len(fields) > 0 && status == "OK" {
+		usable, _ = strconv.ParseInt(fields[0], 10, 64)
+	}
+
+	// New Frame
+	frame := data.NewFrame(qm.Command,
+		data.NewField("Master", nil, []string{qm.Key}),
+		data.NewField("Quorum", nil, []bool{status == "OK"}),
+		data.NewField("Sentinels", nil, []int64{usable}),
+		data.NewField("Status", nil, []string{status}),
+		data.NewField("Message", nil, []string{message}))
+
+	// Add the frame to the response
+	response.Frames = append(response.Frames, frame)
+
+	// Return
+	return response
+}
+
+/**
+ * Create frame from Sentinel entries with field and value pairs
+ *
+ * Fields are added in the order of appearance, numeric fields are returned as int64.
+ */
+func createFrameFromSentinelEntries(name string, entries []interface{}) *data.Frame {
+	frame := data.NewFrame(name)
+
+	var keys []string
+	var rows []map[string]string
+	numeric := map[string]bool{}
+
+	// Parse entries
+	for _, entry := range entries {
+		values, ok := entry.([]interface{})
+		if !ok {
+			continue
+		}
+
+		row := map[string]string{}
+		for i := 0; i+1 < len(values); i += 2 {
+			key, ok := values[i].([]byte)
+			if !ok {
+				continue
+			}
+
+			value := ""
+			switch v := values[i+1].(type) {
+			case []byte:
+				value = string(v)
+			case int64:
+				value = strconv.FormatInt(v, 10)
+			}
+
+			// Add new key
+			if _, ok := numeric[string(key)]; !ok {
+				keys = append(keys, string(key))
+				numeric[string(key)] = true
+			}
+
+			// Check if value is Integer
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				numeric[string(key)] = false
+			}
+
+			row[string(key)] = value
+		}
+
+		rows = append(rows, row)
+	}
+
+	// Add fields
+	for _, key := range keys {
+		if numeric[key] {
+			// Missing values are null
+			values := make([]*int64, len(rows))
+			for i, row := range rows {
+				if value, ok := row[key]; ok {
+					number, _ := strconv.ParseInt(value, 10, 64)
+					values[i] = &number
+				}
+			}
+			field := data.NewField(key, nil, values)
+
+			// Set unit
+			if models.SentinelFieldConfig[key] != "" {
+				field.Config = &data.FieldConfig{Unit: models.SentinelFieldConfig[key]}
+			}
+
+			frame.Fields = append(frame.Fields, field)
+		} else {
+			values := make([]string, len(rows))
+			for i, row := range rows {
+				values[i] = row[key]
+			}
+			frame.Fields = append(frame.Fields, data.NewField(key, nil, values))
+		}
+	}
+
+	return frame
+}
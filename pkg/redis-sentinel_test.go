@@ -0,0 +1,244 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mediocregopher/radix/v3/resp/resp2"
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * SENTINEL MASTERS
+ */
+func TestQuerySentinelMasters(t *testing.T) {
+	t.Parallel()
+
+	// Nullable value
+	value := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name                    string
+		qm                      queryModel
+		rcv                     interface{}
+		fieldsCount             int
+		rowsPerField            int
+		valuesToCheckInResponse []valueToCheckInResponse
+		err                     error
+	}{
+		{
+			"should parse masters",
+			queryModel{Command: models.SentinelMasters},
+			[]interface{}{
+				[]interface{}{
+					[]byte("name"), []byte("mymaster"),
+					[]byte("ip"), []byte("127.0.0.1"),
+					[]byte("port"), []byte("6379"),
+					[]byte("flags"), []byte("master"),
+					[]byte("last-ping-reply"), []byte("358"),
+					[]byte("num-slaves"), []byte("2"),
+					[]byte("quorum"), []byte("2"),
+					[]byte("link-pending-commands"), []byte("0"),
+				},
+				[]interface{}{
+					[]byte("name"), []byte("cache"),
+					[]byte("ip"), []byte("127.0.0.2"),
+					[]byte("port"), []byte("6380"),
+					[]byte("flags"), []byte("master,s_down"),
+					[]byte("last-ping-reply"), []byte("1000"),
+					[]byte("num-slaves"), []byte("0"),
+					[]byte("quorum"), []byte("1"),
+				},
+			},
+			8,
+			2,
+			[]valueToCheckInResponse{
+				{frameIndex: 0, fieldIndex: 0, rowIndex: 0, value: "mymaster"},
+				{frameIndex: 0, fieldIndex: 1, rowIndex: 1, value: "127.0.0.2"},
+				{frameIndex: 0, fieldIndex: 2, rowIndex: 1, value: value(6380)},
+				{frameIndex: 0, fieldIndex: 3, rowIndex: 1, value: "master,s_down"},
+				{frameIndex: 0, fieldIndex: 4, rowIndex: 0, value: value(358)},
+				{frameIndex: 0, fieldIndex: 6, rowIndex: 0, value: value(2)},
+				{frameIndex: 0, fieldIndex: 7, rowIndex: 0, value: value(0)},
+				{frameIndex: 0, fieldIndex: 7, rowIndex: 1, value: (*int64)(nil)},
+			},
+			nil,
+		},
+		{
+			"should handle error",
+			queryModel{Command: models.SentinelMasters},
+			nil,
+			0,
+			0,
+			nil,
+			errors.New("error occurred"),
+		},
+	}
+
+	// Run Tests
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Client
+			client := testClient{rcv: tt.rcv, err: tt.err}
+
+			// Response
+			response := querySentinelMasters(tt.qm, &client)
+			if tt.err != nil {
+				require.EqualError(t, response.Error, tt.err.Error(), "Should set error to response if failed")
+				require.Nil(t, response.Frames, "No frames should be created if failed")
+			} else {
+				require.Equal(t, tt.qm.Command, response.Frames[0].Name, "Invalid frame name")
+				require.Len(t, response.Frames[0].Fields, tt.fieldsCount, "Invalid number of fields created ")
+				require.Equal(t, tt.rowsPerField, response.Frames[0].Fields[0].Len(), "Invalid number of values in field vectors")
+				require.Equal(t, "ms", response.Frames[0].Fields[4].Config.Unit)
+
+				if tt.valuesToCheckInResponse != nil {
+					for _, value := range tt.valuesToCheckInResponse {
+						require.Equalf(t, value.value, response.Frames[value.frameIndex].Fields[value.fieldIndex].At(value.rowIndex), "Invalid value at Frame[%v]:Field[%v]:Row[%v]", value.frameIndex, value.fieldIndex, value.rowIndex)
+					}
+				}
+			}
+		})
+	}
+}
+
+/**
+ * SENTINEL REPLICAS and SENTINELS
+ */
+func TestQuerySentinelInstances(t *testing.T) {
+	t.Parallel()
+
+	// Replicas
+	t.Run("should parse replicas", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{
+			rcv: []interface{}{
+				[]interface{}{
+					[]byte("name"), []byte("127.0.0.1:6380"),
+					[]byte("ip"), []byte("127.0.0.1"),
+					[]byte("port"), []byte("6380"),
+					[]byte("flags"), []byte("slave"),
+					[]byte("master-link-status"), []byte("ok"),
+				},
+			},
+			expectedCmd:  "SENTINEL",
+			expectedArgs: []string{"REPLICAS", "mymaster"},
+		}
+
+		// Response
+		response := querySentinelInstances(queryModel{Command: models.SentinelReplicas, Key: "mymaster"}, &client, "REPLICAS")
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames[0].Fields, 5)
+		require.Equal(t, "master-link-status", response.Frames[0].Fields[4].Name)
+		require.Equal(t, "ok", response.Frames[0].Fields[4].At(0))
+	})
+
+	// Master name
+	t.Run("should require master name", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{}
+
+		// Response
+		response := querySentinelInstances(queryModel{Command: models.SentinelSentinels}, &client, "SENTINELS")
+		require.EqualError(t, response.Error, "master name is required")
+	})
+
+	// Error
+	t.Run("should handle error", func(t *testing.T) {
+		t.Parallel()
+
+		// Client
+		client := testClient{err: errors.New("error occurred")}
+
+		// Response
+		response := querySentinelInstances(queryModel{Command: models.SentinelSentinels, Key: "mymaster"}, &client, "SENTINELS")
+		require.EqualError(t, response.Error, "error occurred")
+		require.Nil(t, response.Frames)
+	})
+}
+
+/**
+ * SENTINEL CKQUORUM
+ */
+func TestQuerySentinelCKQuorum(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                    string
+		qm                      queryModel
+		rcv                     interface{}
+		valuesToCheckInResponse []valueToCheckInResponse
+		err                     error
+		expectedError           string
+	}{
+		{
+			"should parse quorum",
+			queryModel{Command: models.SentinelCKQuorum, Key: "mymaster"},
+			"OK 3 usable Sentinels. Quorum and failover authorization can be reached",
+			[]valueToCheckInResponse{
+				{frameIndex: 0, fieldIndex: 0, rowIndex: 0, value: "mymaster"},
+				{frameIndex: 0, fieldIndex: 1, rowIndex: 0, value: true},
+				{frameIndex: 0, fieldIndex: 2, rowIndex: 0, value: int64(3)},
+				{frameIndex: 0, fieldIndex: 3, rowIndex: 0, value: "OK"},
+			},
+			nil,
+			"",
+		},
+		{
+			"should parse no quorum",
+			queryModel{Command: models.SentinelCKQuorum, Key: "mymaster"},
+			nil,
+			[]valueToCheckInResponse{
+				{frameIndex: 0, fieldIndex: 1, rowIndex: 0, value: false},
+				{frameIndex: 0, fieldIndex: 2, rowIndex: 0, value: int64(0)},
+				{frameIndex: 0, fieldIndex: 3, rowIndex: 0, value: "NOQUORUM"},
+				{frameIndex: 0, fieldIndex: 4, rowIndex: 0, value: "1 usable Sentinels. Not enough available Sentinels to reach the majority"},
+			},
+			resp2.Error{E: errors.New("NOQUORUM 1 usable Sentinels. Not enough available Sentinels to reach the majority")},
+			"",
+		},
+		{
+			"should handle error",
+			queryModel{Command: models.SentinelCKQuorum, Key: "unknown"},
+			nil,
+			nil,
+			resp2.Error{E: errors.New("ERR No such master with that name")},
+			"ERR No such master with that name",
+		},
+	}
+
+	// Run Tests
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Client
+			client := testClient{rcv: tt.rcv, err: tt.err}
+
+			// Response
+			response := querySentinelCKQuorum(tt.qm, &client)
+			if tt.expectedError != "" {
+				require.EqualError(t, response.Error, tt.expectedError, "Should set error to response if failed")
+				require.Nil(t, response.Frames, "No frames should be created if failed")
+			} else {
+				require.NoError(t, response.Error)
+				require.Len(t, response.Frames[0].Fields, 5, "Invalid number of fields created ")
+
+				for _, value := range tt.valuesToCheckInResponse {
+					require.Equalf(t, value.value, response.Frames[value.frameIndex].Fields[value.fieldIndex].At(value.rowIndex), "Invalid value at Frame[%v]:Field[%v]:Row[%v]", value.frameIndex, value.fieldIndex, value.rowIndex)
+				}
+			}
+		})
+	}
+}
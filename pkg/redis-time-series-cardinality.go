@@ -0,0 +1,182 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"bitbucket.org/creachadair/shell"
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+)
+
+/**
+ * Label statistics
+ */
+type tsLabel struct {
+	name   string
+	values int64
+	series int64
+	memory int64
+}
+
+/**
+ * Label value statistics
+ */
+type tsLabelValue struct {
+	label   string
+	value   string
+	series  int64
+	samples int64
+	memory  int64
+}
+
+/**
+ * Time-series cardinality using TS.QUERYINDEX and pipelined TS.INFO
+ *
+ * Returns total, per label and per label value number of time-series, samples and memory.
+ */
+func queryTsCardinality(qm queryModel, client redisClient) backend.DataResponse {
+	response := backend.DataResponse{}
+
+	// Split Filter to array
+	filter, ok := shell.Split(qm.Filter)
+
+	// Check if filter is valid
+	if !ok {
+		response.Error = fmt.Errorf("filter is not valid")
+		return response
+	}
+
+	// Filter is required
+	if len(filter) == 0 {
+		return errorHandler(response, errors.New("filter is required"))
+	}
+
+	// Execute command
+	var keys []string
+	err := client.RunCmd(&keys, models.TimeSeriesQueryIndex, filter...)
+
+	// Check error
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	var totalSamples, totalMemory int64
+	values := map[string]*tsLabelValue{}
+
+	// Send batches with TS.INFO commands
+	for start := 0; start < len(keys); start += models.TimeSeriesCardinalityBatchSize {
+		end := start + models.TimeSeriesCardinalityBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		infos := make([]map[string]interface{}, end-start)
+		var commands []flatCommandArgs
+		for i, key := range keys[start:end] {
+			commands = append(commands, flatCommandArgs{cmd: models.TimeSeriesInfo, key: key, rcv: &infos[i]})
+		}
+
+		err = client.RunBatchFlatCmd(commands)
+
+		// Check error
+		if err != nil {
+			return errorHandler(response, err)
+		}
+
+		// Statistics
+		for _, info := range infos {
+			samples, _ := info["totalSamples"].(int64)
+			memory, _ := info["memoryUsage"].(int64)
+			totalSamples += samples
+			totalMemory += memory
+
+			labelsRaw, _ := info["labels"].([]interface{})
+			for label, value := range parseTsLabels(labelsRaw) {
+				id := label + "=" + value
+				if values[id] == nil {
+					values[id] = &tsLabelValue{label: label, value: value}
+				}
+
+				values[id].series++
+				values[id].samples += samples
+				values[id].memory += memory
+			}
+		}
+	}
+
+	// Total
+	frame := data.NewFrame(qm.Command,
+		data.NewField("Series", nil, []int64{int64(len(keys))}),
+		data.NewField("Samples", nil, []int64{totalSamples}),
+		data.NewField("Memory", nil, []int64{totalMemory}))
+	frame.Fields[2].Config = &data.FieldConfig{Unit: "decbytes"}
+
+	// Label values sorted by number of time-series
+	var rows []*tsLabelValue
+	for _, row := range values {
+		rows = append(rows, row)
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].series != rows[j].series {
+			return rows[i].series > rows[j].series
+		}
+		if rows[i].label != rows[j].label {
+			return rows[i].label < rows[j].label
+		}
+		return rows[i].value < rows[j].value
+	})
+
+	// Labels sorted by number of values
+	labels := map[string]*tsLabel{}
+	var labelRows []*tsLabel
+	for _, row := range rows {
+		if labels[row.label] == nil {
+			labels[row.label] = &tsLabel{name: row.label}
+			labelRows = append(labelRows, labels[row.label])
+		}
+
+		labels[row.label].values++
+		labels[row.label].series += row.series
+		labels[row.label].memory += row.memory
+	}
+
+	sort.SliceStable(labelRows, func(i, j int) bool { return labelRows[i].values > labelRows[j].values })
+
+	labelsFrame := data.NewFrame("labels",
+		data.NewField("Label", nil, []string{}),
+		data.NewField("Values", nil, []int64{}),
+		data.NewField("Series", nil, []int64{}),
+		data.NewField("Memory", nil, []int64{}))
+	labelsFrame.Fields[3].Config = &data.FieldConfig{Unit: "decbytes"}
+
+	for _, row := range labelRows {
+		labelsFrame.AppendRow(row.name, row.values, row.series, row.memory)
+	}
+
+	// Return only top label values
+	if qm.Size > 0 && qm.Size < len(rows) {
+		rows = rows[:qm.Size]
+	}
+
+	valuesFrame := data.NewFrame("values",
+		data.NewField("Label", nil, []string{}),
+		data.NewField("Value", nil, []string{}),
+		data.NewField("Series", nil, []int64{}),
+		data.NewField("Samples", nil, []int64{}),
+		data.NewField("Memory", nil, []int64{}))
+	valuesFrame.Fields[4].Config = &data.FieldConfig{Unit: "decbytes"}
+
+	for _, row := range rows {
+		valuesFrame.AppendRow(row.label, row.value, row.series, row.samples, row.memory)
+	}
+
+	// Add the frames to the response
+	response.Frames = append(response.Frames, frame, labelsFrame, valuesFrame)
+
+	// Return
+	return response
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Time-series cardinality
+ */
+func TestQueryTsCardinality(t *testing.T) {
+	t.Parallel()
+
+	info := func(samples int64, memory int64, labels ...string) map[string]interface{} {
+		var labelsRaw []interface{}
+		for i := 0; i+1 < len(labels); i += 2 {
+			labelsRaw = append(labelsRaw, []interface{}{[]byte(labels[i]), []byte(labels[i+1])})
+		}
+
+		return map[string]interface{}{"totalSamples": samples, "memoryUsage": memory, "labels": labelsRaw}
+	}
+
+	// Cardinality
+	t.Run("should return cardinality per label and value", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:          []string{"cpu:1", "cpu:2", "cpu:3"},
+			expectedCmd:  models.TimeSeriesQueryIndex,
+			expectedArgs: []string{"type=cpu"},
+			batchRcv: [][]interface{}{{
+				info(100, 4096, "type", "cpu", "host", "a"),
+				info(200, 4096, "type", "cpu", "host", "b"),
+				info(300, 8192, "type", "cpu", "host", "c", "region", "eu"),
+			}},
+		}
+
+		response := queryTsCardinality(queryModel{Command: models.TSCardinality, Filter: "type=cpu", Size: 3}, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 3)
+
+		// Total
+		require.Equal(t, int64(3), response.Frames[0].Fields[0].At(0))
+		require.Equal(t, int64(600), response.Frames[0].Fields[1].At(0))
+		require.Equal(t, int64(16384), response.Frames[0].Fields[2].At(0))
+
+		// Labels
+		labels := response.Frames[1]
+		require.Equal(t, 3, labels.Fields[0].Len())
+		require.Equal(t, "host", labels.Fields[0].At(0))
+		require.Equal(t, int64(3), labels.Fields[1].At(0))
+		require.Equal(t, int64(3), labels.Fields[2].At(0))
+		require.Equal(t, "type", labels.Fields[0].At(1))
+		require.Equal(t, int64(1), labels.Fields[1].At(1))
+		require.Equal(t, int64(16384), labels.Fields[3].At(1))
+
+		// Top label values
+		values := response.Frames[2]
+		require.Equal(t, 3, values.Fields[0].Len())
+		require.Equal(t, "type", values.Fields[0].At(0))
+		require.Equal(t, "cpu", values.Fields[1].At(0))
+		require.Equal(t, int64(3), values.Fields[2].At(0))
+		require.Equal(t, int64(600), values.Fields[3].At(0))
+		require.Equal(t, "a", values.Fields[1].At(1))
+	})
+
+	// Filter
+	t.Run("should require filter", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{}
+		response := queryTsCardinality(queryModel{Command: models.TSCardinality}, &client)
+		require.EqualError(t, response.Error, "filter is required")
+	})
+
+	// Error
+	t.Run("should handle batch error", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:      []string{"cpu:1"},
+			batchRcv: [][]interface{}{{info(0, 0)}},
+			batchErr: []error{errors.New("error occurred")},
+		}
+
+		response := queryTsCardinality(queryModel{Command: models.TSCardinality, Filter: "type=cpu"}, &client)
+		require.EqualError(t, response.Error, "error occurred")
+		require.Nil(t, response.Frames)
+	})
+}
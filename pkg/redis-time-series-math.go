@@ -0,0 +1,291 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+)
+
+/**
+ * Expression with function name and arguments, i.e. ratio(errors, requests)
+ */
+var tsExpressionRegexp = regexp.MustCompile(`^\s*(\w+)\s*(?:\((.*)\))?\s*$`)
+
+/**
+ * Parse expression to the lower case function name and arguments
+ */
+func parseTsExpression(expression string) (string, []string, error) {
+	match := tsExpressionRegexp.FindStringSubmatch(expression)
+	if match == nil {
+		return "", nil, fmt.Errorf("expression is not valid: %s", expression)
+	}
+
+	var args []string
+	if strings.TrimSpace(match[2]) != "" {
+		for _, arg := range strings.Split(match[2], ",") {
+			args = append(args, strings.TrimSpace(arg))
+		}
+	}
+
+	return strings.ToLower(match[1]), args, nil
+}
+
+/**
+ * Check if expression is evaluated across multiple time-series
+ */
+func isTsMultiSeriesExpression(name string) bool {
+	return name == models.TimeSeriesExpressionRatio || name == models.TimeSeriesExpressionPercentile
+}
+
+/**
+ * Evaluate expression over time-series returned by TS.RANGE and TS.MRANGE
+ *
+ * Supported expressions:
+ * - rate: per-second rate of increase, counter resets are handled
+ * - delta: difference between consecutive samples
+ * - moving_avg(n): moving average over n samples
+ * - ratio(a, b): ratio of time-series a and b joined on time
+ * - percentile(p): p-th percentile across time-series joined on time
+ *
+ * Ratio and percentile are evaluated across time-series returned by the same TS.MRANGE query,
+ * labels with the same value in all operands are kept.
+ */
+func evalTsExpression(series []tsSeries, expression string) ([]tsSeries, error) {
+	if strings.TrimSpace(expression) == "" {
+		return series, nil
+	}
+
+	// Parse expression
+	name, args, err := parseTsExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case models.TimeSeriesExpressionRate, models.TimeSeriesExpressionDelta:
+		result := make([]tsSeries, len(series))
+		for i, s := range series {
+			result[i] = s
+			result[i].samples = tsDelta(s.samples, name == models.TimeSeriesExpressionRate)
+		}
+
+		return result, nil
+	case models.TimeSeriesExpressionMovingAverage:
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s requires number of samples", name)
+		}
+
+		window, err := strconv.Atoi(args[0])
+		if err != nil || window < 1 {
+			return nil, fmt.Errorf("%s requires positive number of samples", name)
+		}
+
+		result := make([]tsSeries, len(series))
+		for i, s := range series {
+			result[i] = s
+			result[i].samples = tsMovingAverage(s.samples, window)
+		}
+
+		return result, nil
+	case models.TimeSeriesExpressionRatio:
+		if len(args) != 2 {
+			return nil, fmt.Errorf("%s requires two time-series", name)
+		}
+
+		a, b := findTsSeries(series, args[0]), findTsSeries(series, args[1])
+		if a == nil || b == nil {
+			return nil, fmt.Errorf("%s requires time-series %s and %s", name, args[0], args[1])
+		}
+
+		// Join on time
+		divisors := map[int64]*float64{}
+		for _, sample := range b.samples {
+			divisors[sample.timestamp] = sample.value
+		}
+
+		var samples []tsSample
+		for _, sample := range a.samples {
+			divisor, ok := divisors[sample.timestamp]
+			if !ok {
+				continue
+			}
+
+			ratio := tsSample{timestamp: sample.timestamp}
+			if sample.value != nil && divisor != nil && *divisor != 0 {
+				v := *sample.value / *divisor
+				ratio.value = &v
+			}
+
+			samples = append(samples, ratio)
+		}
+
+		return []tsSeries{{name: a.name + "/" + b.name, value: a.value, labels: commonTsLabels([]tsSeries{*a, *b}), samples: samples}}, nil
+	case models.TimeSeriesExpressionPercentile:
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s requires percentile", name)
+		}
+
+		p, err := strconv.ParseFloat(args[0], 64)
+		if err != nil || p < 0 || p > 100 {
+			return nil, fmt.Errorf("%s requires percentile between 0 and 100", name)
+		}
+
+		// Join on time
+		values := map[int64][]float64{}
+		var timestamps []int64
+		for _, s := range series {
+			for _, sample := range s.samples {
+				if _, ok := values[sample.timestamp]; !ok {
+					timestamps = append(timestamps, sample.timestamp)
+					values[sample.timestamp] = nil
+				}
+
+				if sample.value != nil && !math.IsNaN(*sample.value) {
+					values[sample.timestamp] = append(values[sample.timestamp], *sample.value)
+				}
+			}
+		}
+
+		sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+
+		samples := make([]tsSample, len(timestamps))
+		for i, ts := range timestamps {
+			samples[i] = tsSample{timestamp: ts, value: tsPercentile(values[ts], p)}
+		}
+
+		return []tsSeries{{name: "p" + args[0], labels: commonTsLabels(series), samples: samples}}, nil
+	}
+
+	return nil, fmt.Errorf("expression is not supported: %s", name)
+}
+
+/**
+ * Labels with the same value in all time-series
+ */
+func commonTsLabels(series []tsSeries) map[string]string {
+	if len(series) == 0 || len(series[0].labels) == 0 {
+		return nil
+	}
+
+	labels := map[string]string{}
+	for key, value := range series[0].labels {
+		labels[key] = value
+	}
+
+	for _, s := range series[1:] {
+		for key, value := range labels {
+			if s.labels[key] != value {
+				delete(labels, key)
+			}
+		}
+	}
+
+	if len(labels) == 0 {
+		return nil
+	}
+
+	return labels
+}
+
+/**
+ * Find time-series by name, which is the legend or key
+ */
+func findTsSeries(series []tsSeries, name string) *tsSeries {
+	for i := range series {
+		if series[i].name == name {
+			return &series[i]
+		}
+	}
+
+	return nil
+}
+
+/**
+ * Difference between consecutive samples or per-second rate
+ *
+ * Decrease of the value is considered as counter reset for the rate.
+ */
+func tsDelta(samples []tsSample, rate bool) []tsSample {
+	var result []tsSample
+
+	for i := 1; i < len(samples); i++ {
+		prev, sample := samples[i-1], samples[i]
+		delta := tsSample{timestamp: sample.timestamp}
+
+		if prev.value != nil && sample.value != nil {
+			v := *sample.value - *prev.value
+
+			if rate {
+				// Counter reset
+				if v < 0 {
+					v = *sample.value
+				}
+
+				// Per second
+				if seconds := float64(sample.timestamp-prev.timestamp) / 1000; seconds > 0 {
+					v = v / seconds
+				} else {
+					continue
+				}
+			}
+
+			delta.value = &v
+		}
+
+		result = append(result, delta)
+	}
+
+	return result
+}
+
+/**
+ * Moving average over window of samples, missing values are skipped
+ */
+func tsMovingAverage(samples []tsSample, window int) []tsSample {
+	result := make([]tsSample, len(samples))
+
+	for i, sample := range samples {
+		result[i] = tsSample{timestamp: sample.timestamp}
+
+		var sum float64
+		var count int
+		for j := i - window + 1; j <= i; j++ {
+			if j < 0 || samples[j].value == nil || math.IsNaN(*samples[j].value) {
+				continue
+			}
+
+			sum += *samples[j].value
+			count++
+		}
+
+		if count > 0 {
+			v := sum / float64(count)
+			result[i].value = &v
+		}
+	}
+
+	return result
+}
+
+/**
+ * Percentile with linear interpolation between closest ranks
+ */
+func tsPercentile(values []float64, p float64) *float64 {
+	if len(values) == 0 {
+		return nil
+	}
+
+	sort.Float64s(values)
+
+	rank := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	v := values[lower] + (values[upper]-values[lower])*(rank-float64(lower))
+	return &v
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Expressions
+ */
+func TestEvalTsExpression(t *testing.T) {
+	t.Parallel()
+
+	value := func(v float64) *float64 { return &v }
+	series := func() []tsSeries {
+		return []tsSeries{
+			{name: "errors", samples: []tsSample{
+				{timestamp: 1000, value: value(10)},
+				{timestamp: 3000, value: value(30)},
+				{timestamp: 4000, value: value(5)},
+				{timestamp: 5000, value: nil},
+			}},
+			{name: "requests", samples: []tsSample{
+				{timestamp: 1000, value: value(100)},
+				{timestamp: 3000, value: value(0)},
+				{timestamp: 4000, value: value(50)},
+				{timestamp: 6000, value: value(60)},
+			}},
+			{name: "latency", samples: []tsSample{
+				{timestamp: 1000, value: value(40)},
+				{timestamp: 6000, value: value(20)},
+			}},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		expression    string
+		expected      map[string][]*float64
+		expectedError string
+	}{
+		{
+			"should return time-series without expression",
+			"",
+			map[string][]*float64{"errors": {value(10), value(30), value(5), nil}},
+			"",
+		},
+		{
+			"should calculate rate with counter reset",
+			"rate",
+			map[string][]*float64{"errors": {value(10), value(5), nil}, "latency": {value(4)}},
+			"",
+		},
+		{
+			"should calculate delta",
+			"DELTA()",
+			map[string][]*float64{"errors": {value(20), value(-25), nil}, "requests": {value(-100), value(50), value(10)}},
+			"",
+		},
+		{
+			"should calculate moving average",
+			"moving_avg(2)",
+			map[string][]*float64{"errors": {value(10), value(20), value(17.5), value(5)}},
+			"",
+		},
+		{
+			"should calculate ratio",
+			"ratio(errors, requests)",
+			map[string][]*float64{"errors/requests": {value(0.1), nil, value(0.1)}},
+			"",
+		},
+		{
+			"should calculate percentile across time-series",
+			"percentile(50)",
+			map[string][]*float64{"p50": {value(40), value(15), value(27.5), nil, value(40)}},
+			"",
+		},
+		{
+			"should require existing time-series for ratio",
+			"ratio(errors, unknown)",
+			nil,
+			"ratio requires time-series errors and unknown",
+		},
+		{
+			"should validate moving average window",
+			"moving_avg(0)",
+			nil,
+			"moving_avg requires positive number of samples",
+		},
+		{
+			"should validate percentile",
+			"percentile(101)",
+			nil,
+			"percentile requires percentile between 0 and 100",
+		},
+		{
+			"should return error for unknown expression",
+			"sum",
+			nil,
+			"expression is not supported: sum",
+		},
+		{
+			"should return error for invalid expression",
+			"rate(",
+			nil,
+			"expression is not valid: rate(",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := evalTsExpression(series(), tt.expression)
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			for name, expected := range tt.expected {
+				s := findTsSeries(result, name)
+				require.NotNilf(t, s, "Time-series %s not found", name)
+				require.Len(t, s.samples, len(expected))
+
+				for i, sample := range s.samples {
+					if expected[i] == nil {
+						require.Nilf(t, sample.value, "Invalid value of %s at %v", name, i)
+					} else {
+						require.NotNilf(t, sample.value, "Invalid value of %s at %v", name, i)
+						require.InDeltaf(t, *expected[i], *sample.value, 0.0001, "Invalid value of %s at %v", name, i)
+					}
+				}
+			}
+		})
+	}
+
+	// Range
+	t.Run("should evaluate expression for range", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{rcv: [][]string{{"1000", "1"}, {"2000", "3"}, {"4000", "7"}}}
+		response := queryTsRange(0, 0, queryModel{Command: models.TimeSeriesRange, Key: "test1", Expression: "rate"}, &client)
+		require.NoError(t, response.Error)
+		require.Equal(t, 2, response.Frames[0].Fields[0].Len())
+		require.Equal(t, 2.0, response.Frames[0].Fields[1].At(0))
+		require.Equal(t, 2.0, response.Frames[0].Fields[1].At(1))
+	})
+
+	// Labels
+	t.Run("should keep common labels", func(t *testing.T) {
+		t.Parallel()
+
+		labeled := series()
+		labeled[0].labels = map[string]string{"service": "api", "type": "errors"}
+		labeled[1].labels = map[string]string{"service": "api", "type": "requests"}
+		labeled[2].labels = map[string]string{"service": "web", "type": "latency"}
+
+		result, err := evalTsExpression(labeled, "ratio(errors, requests)")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"service": "api"}, result[0].labels)
+
+		result, err = evalTsExpression(labeled, "percentile(90)")
+		require.NoError(t, err)
+		require.Nil(t, result[0].labels)
+
+		result, err = evalTsExpression(labeled, "rate")
+		require.NoError(t, err)
+		require.Equal(t, labeled[2].labels, result[2].labels)
+	})
+
+	// Range with multi time-series expression
+	t.Run("should return error for ratio over range", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{err: errors.New("command should not be executed")}
+		response := queryTsRange(0, 0, queryModel{Command: models.TimeSeriesRange, Key: "test1", Expression: "ratio(a, b)"}, &client)
+		require.EqualError(t, response.Error, "ratio requires multiple time-series returned by TS.MRANGE or TS.MREVRANGE")
+	})
+
+	// Multi range
+	t.Run("should return expression error for multi range", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{rcv: []interface{}{}}
+		response := queryTsMRange(0, 0, queryModel{Command: models.TimeSeriesMRange, Filter: "type=cpu", Expression: "ratio(a, b)"}, &client)
+		require.EqualError(t, response.Error, "ratio requires time-series a and b")
+	})
+}
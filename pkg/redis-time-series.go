@@ -3,7 +3,11 @@ package main
 import (
 	"errors"
 	"fmt"
+	"math"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"bitbucket.org/creachadair/shell"
@@ -14,74 +18,89 @@ import (
 )
 
 /**
- * TS.RANGE key fromTimestamp toTimestamp [COUNT count] [AGGREGATION aggregationType timeBucket]
+ * TS.RANGE key fromTimestamp toTimestamp [LATEST] [FILTER_BY_TS ts...] [FILTER_BY_VALUE min max] [COUNT count]
+ *   [[ALIGN align] AGGREGATION aggregationType timeBucket [BUCKETTIMESTAMP bt] [EMPTY]]
+ * TS.REVRANGE key fromTimestamp toTimestamp [LATEST] [FILTER_BY_TS ts...] [FILTER_BY_VALUE min max] [COUNT count]
+ *   [[ALIGN align] AGGREGATION aggregationType timeBucket [BUCKETTIMESTAMP bt] [EMPTY]]
  *
- * @see https://oss.redislabs.com/redistimeseries/commands/#tsrangetsrevrange
+ * @see https://redis.io/commands/ts.range/
  */
 func queryTsRange(from int64, to int64, qm queryModel, client redisClient) backend.DataResponse {
 	response := backend.DataResponse{}
 
-	var result [][]string
-	var err error
+	// Range options
+	options, err := tsRangeOptions(qm)
+	if err != nil {
+		return errorHandler(response, err)
+	}
 
-	// Execute command
-	if qm.Aggregation != "" {
-		err = client.RunFlatCmd(&result, qm.Command, qm.Key, from, to, "AGGREGATION", qm.Aggregation, qm.Bucket)
-	} else {
-		err = client.RunFlatCmd(&result, qm.Command, qm.Key, from, to)
+	// Expression, ratio and percentile require multiple time-series returned by TS.MRANGE
+	if strings.TrimSpace(qm.Expression) != "" {
+		name, _, err := parseTsExpression(qm.Expression)
+		if err != nil {
+			return errorHandler(response, err)
+		}
+
+		if isTsMultiSeriesExpression(name) {
+			return errorHandler(response, fmt.Errorf("%s requires multiple time-series returned by TS.MRANGE or TS.MREVRANGE", name))
+		}
 	}
 
+	// Execute command
+	var result [][]string
+	err = client.RunFlatCmd(&result, qm.Command, qm.Key, append([]interface{}{from, to}, options...)...)
+
 	// Check error
 	if err != nil {
 		return errorHandler(response, err)
 	}
 
+	// Parse samples
+	samples := make([]tsSample, 0, len(result))
+	for _, row := range result {
+		t, _ := strconv.ParseInt(row[0], 10, 64)
+		v, _ := strconv.ParseFloat(row[1], 64)
+		samples = append(samples, tsSample{timestamp: t, value: &v})
+	}
+
+	// Return samples in ascending time order
+	if qm.Command == models.TimeSeriesRevRange {
+		reverseTsSamples(samples)
+	}
+
+	// Fill missing intervals
+	samples, err = fillTsSamples(from, to, qm, samples)
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
 	// Legend
 	legend := qm.Key
 	if qm.Legend != "" {
 		legend = qm.Legend
 	}
 
-	// Create data frame response
-	frame := data.NewFrame(legend,
-		data.NewField("time", nil, []time.Time{}),
-		data.NewField(qm.Value, nil, []float64{}))
-
-	// Previous time to fill missing intervals
-	var prevTime time.Time
-
-	// Add rows
-	for _, row := range result {
-		t, _ := strconv.ParseInt(row[0], 10, 64)
-		ts := time.Unix(0, t*int64(time.Millisecond))
-
-		// Fill missing intervals
-		if qm.Fill && qm.Bucket != 0 {
-			if !prevTime.IsZero() {
-				for ts.Sub(prevTime) > time.Duration(qm.Bucket)*time.Millisecond {
-					prevTime = prevTime.Add(time.Duration(qm.Bucket) * time.Millisecond)
-					frame.AppendRow(prevTime, float64(0))
-				}
-			}
-
-			prevTime = ts
-		}
-
-		v, _ := strconv.ParseFloat(row[1], 64)
-		frame.AppendRow(ts, v)
+	series, err := evalTsExpression([]tsSeries{{name: legend, value: qm.Value, samples: samples}}, qm.Expression)
+	if err != nil {
+		return errorHandler(response, err)
 	}
 
 	// Add the frames to the response
-	response.Frames = append(response.Frames, frame)
+	for _, s := range series {
+		response.Frames = append(response.Frames, createTsFrame(s.name, s.value, nil, s.samples, qm))
+	}
 
 	// Return Response
 	return response
 }
 
 /**
- * TS.MRANGE fromTimestamp toTimestamp [COUNT count] [AGGREGATION aggregationType timeBucket] [WITHLABELS] FILTER filter..
+ * TS.MRANGE fromTimestamp toTimestamp [LATEST] [FILTER_BY_TS ts...] [FILTER_BY_VALUE min max] [COUNT count]
+ *   [[ALIGN align] AGGREGATION aggregationType timeBucket [BUCKETTIMESTAMP bt] [EMPTY]] [WITHLABELS | SELECTED_LABELS label...] FILTER filter..
+ * TS.MREVRANGE fromTimestamp toTimestamp [LATEST] [FILTER_BY_TS ts...] [FILTER_BY_VALUE min max] [COUNT count]
+ *   [[ALIGN align] AGGREGATION aggregationType timeBucket [BUCKETTIMESTAMP bt] [EMPTY]] [WITHLABELS | SELECTED_LABELS label...] FILTER filter..
  *
- * @see https://oss.redislabs.com/redistimeseries/commands/#tsmrangetsmrevrange
+ * @see https://redis.io/commands/ts.mrange/
  */
 func queryTsMRange(from int64, to int64, qm queryModel, client redisClient) backend.DataResponse {
 	response := backend.DataResponse{}
@@ -98,16 +117,17 @@ func queryTsMRange(from int64, to int64, qm queryModel, client redisClient) back
 		return response
 	}
 
-	var args []interface{}
-	//args := []interface{}{strconv.FormatInt(from, 10), to}
-
-	// Execute command
-	if qm.Aggregation != "" {
-		args = []interface{}{to, "AGGREGATION", qm.Aggregation, qm.Bucket, "WITHLABELS", "FILTER", filter}
-	} else {
-		args = []interface{}{to, qm.Bucket, "WITHLABELS", "FILTER", filter}
+	// Range options
+	options, err := tsRangeOptions(qm)
+	if err != nil {
+		return errorHandler(response, err)
 	}
 
+	args := append([]interface{}{to}, options...)
+
+	args = append(args, tsLabelsArgs(qm)...)
+	args = append(args, "FILTER", filter)
+
 	if qm.TsGroupByLabel != "" {
 		if qm.TsReducer == "" {
 			return errorHandler(response, errors.New("reducer not provided for groups, please provide a reducer (e.g. avg, sum) and try again"))
@@ -131,26 +151,15 @@ func queryTsMRange(from int64, to int64, qm queryModel, client redisClient) back
 	}
 
 	// Parse Time-Series data
+	var series []tsSeries
 	for _, innerArray := range result.([]interface{}) {
 		tsArrReply := innerArray.([]interface{})
 
 		// Labels
-		labelsRaw := tsArrReply[1].([]interface{})
-		labels := make(map[string]string, len(labelsRaw))
-
-		// Parse Labels
-		for _, labelRaw := range labelsRaw {
-			kvPair := labelRaw.([]interface{})
-			k := string(kvPair[0].([]byte))
-			v := string(kvPair[1].([]byte))
-			labels[k] = v
-		}
+		labels := parseTsLabels(tsArrReply[1].([]interface{}))
 
 		// Use Time-series's name as Legend if Legend label is not specified
-		legend := string(tsArrReply[0].([]byte))
-		if qm.Legend != "" {
-			legend = labels[qm.Legend]
-		}
+		legend := tsLegend(string(tsArrReply[0].([]byte)), labels, qm)
 
 		// Use value's label if specified
 		value := ""
@@ -158,70 +167,590 @@ func queryTsMRange(from int64, to int64, qm queryModel, client redisClient) back
 			value = labels[qm.Value]
 		}
 
-		// Create Frame
-		frame := data.NewFrame(legend,
-			data.NewField("time", nil, []time.Time{}))
+		// Values
+		values := tsArrReply[2].([]interface{})
+		samples := make([]tsSample, 0, len(values))
 
-		// Return labels if legend is not specified
-		if qm.Legend != "" {
-			frame.Fields = append(frame.Fields,
-				data.NewField(value, nil, []float64{}),
-			)
-		} else {
-			frame.Fields = append(frame.Fields,
-				data.NewField(value, labels, []float64{}),
-			)
+		for _, valueRaw := range values {
+			samples = append(samples, parseTsSample(valueRaw.([]interface{})))
 		}
 
-		// Previous time to fill missing intervals
-		var prevTime time.Time
+		// Return samples in ascending time order
+		if qm.Command == models.TimeSeriesMRevRange {
+			reverseTsSamples(samples)
+		}
 
-		// Values
-		for _, valueRaw := range tsArrReply[2].([]interface{}) {
-			kvPair := valueRaw.([]interface{})
-			var k int64
-			var v float64
-
-			// Key
-			switch kvPair[0].(type) {
-			case []byte:
-				k, _ = strconv.ParseInt(string(kvPair[0].([]byte)), 10, 64)
-			default:
-				k = kvPair[0].(int64)
+		// Fill missing intervals
+		samples, err = fillTsSamples(from, to, qm, samples)
+		if err != nil {
+			return errorHandler(response, err)
+		}
+
+		series = append(series, tsSeries{name: legend, value: value, labels: labels, samples: samples})
+	}
+
+	// Expression
+	series, err = evalTsExpression(series, qm.Expression)
+	if err != nil {
+		return errorHandler(response, err)
+	}
+
+	// Add the frames to the response
+	response.Frames = append(response.Frames, createTsSeriesFrames(series, qm)...)
+
+	// Return Response
+	return response
+}
+
+/**
+ * Range options shared by TS.RANGE, TS.REVRANGE, TS.MRANGE and TS.MREVRANGE
+ *
+ * ALIGN, BUCKETTIMESTAMP and EMPTY are only applicable to aggregations.
+ */
+func tsRangeOptions(qm queryModel) ([]interface{}, error) {
+	var options []interface{}
+
+	// Latest sample of the compacted time-series
+	if qm.Latest {
+		options = append(options, "LATEST")
+	}
+
+	// Filter by timestamps
+	if qm.FilterByTs != "" {
+		options = append(options, "FILTER_BY_TS")
+		for _, ts := range strings.Fields(strings.ReplaceAll(qm.FilterByTs, ",", " ")) {
+			if _, err := strconv.ParseInt(ts, 10, 64); err != nil {
+				return nil, fmt.Errorf("filter by timestamp is not valid: %s", ts)
 			}
+			options = append(options, ts)
+		}
+	}
+
+	// Filter by value
+	if qm.FilterByValueMin != "" || qm.FilterByValueMax != "" {
+		if qm.FilterByValueMin == "" || qm.FilterByValueMax == "" {
+			return nil, errors.New("filter by value requires minimum and maximum values")
+		}
+		options = append(options, "FILTER_BY_VALUE", qm.FilterByValueMin, qm.FilterByValueMax)
+	}
+
+	// Count
+	if qm.Count > 0 {
+		options = append(options, "COUNT", qm.Count)
+	}
+
+	// Aggregation
+	if qm.Aggregation == "" {
+		return options, nil
+	}
+
+	if qm.Align != "" {
+		options = append(options, "ALIGN", qm.Align)
+	}
+
+	options = append(options, "AGGREGATION", qm.Aggregation, qm.Bucket)
+
+	if qm.BucketTimestamp != "" {
+		options = append(options, "BUCKETTIMESTAMP", qm.BucketTimestamp)
+	}
+
+	// Report empty buckets
+	if qm.Empty {
+		options = append(options, "EMPTY")
+	}
+
+	return options, nil
+}
+
+/**
+ * Time bucket for aggregation
+ *
+ * Automatic time bucket is derived from the query interval and maximum number of data points,
+ * rounded up to the nearest time bucket and not less than the minimum time bucket.
+ */
+func tsBucket(query backend.DataQuery, qm queryModel) int {
+	if !qm.AutoBucket {
+		return qm.Bucket
+	}
+
+	// Interval and data points are not provided by alerting and API requests
+	if query.Interval <= 0 && query.MaxDataPoints <= 0 {
+		if qm.MinBucket > qm.Bucket {
+			return qm.MinBucket
+		}
+
+		return qm.Bucket
+	}
+
+	// Interval
+	bucket := query.Interval.Milliseconds()
+
+	// Maximum number of data points for the time range
+	if query.MaxDataPoints > 0 {
+		timeRange := query.TimeRange.To.Sub(query.TimeRange.From).Milliseconds()
+		if points := (timeRange + query.MaxDataPoints - 1) / query.MaxDataPoints; points > bucket {
+			bucket = points
+		}
+	}
+
+	// Round up to the nearest time bucket or to days
+	rounded := int64(0)
+	for _, b := range models.TimeSeriesAutoBuckets {
+		if b >= bucket {
+			rounded = b
+			break
+		}
+	}
+
+	if rounded == 0 {
+		day := int64(24 * time.Hour / time.Millisecond)
+		rounded = (bucket + day - 1) / day * day
+	}
+
+	// Minimum time bucket
+	if rounded < int64(qm.MinBucket) {
+		rounded = int64(qm.MinBucket)
+	}
+
+	return int(rounded)
+}
+
+/**
+ * Parse time-series labels
+ *
+ * Labels selected with SELECTED_LABELS are returned with empty value if not set.
+ */
+func parseTsLabels(labelsRaw []interface{}) map[string]string {
+	labels := make(map[string]string, len(labelsRaw))
+
+	for _, labelRaw := range labelsRaw {
+		kvPair := labelRaw.([]interface{})
+		k := string(kvPair[0].([]byte))
+
+		v := ""
+		if value, ok := kvPair[1].([]byte); ok {
+			v = string(value)
+		}
+
+		labels[k] = v
+	}
 
-			ts := time.Unix(0, k*int64(time.Millisecond))
+	return labels
+}
+
+/**
+ * Legend template with labels, i.e. {{region}}-{{host}}
+ */
+var tsLegendTemplate = regexp.MustCompile(`\{\{\s*([^{}\s]+)\s*\}\}`)
+
+/**
+ * Time-series legend from the label or template, time-series name by default
+ */
+func tsLegend(name string, labels map[string]string, qm queryModel) string {
+	switch {
+	case qm.Legend == "":
+		return name
+	case strings.Contains(qm.Legend, "{{"):
+		return tsLegendTemplate.ReplaceAllStringFunc(qm.Legend, func(match string) string {
+			return labels[tsLegendTemplate.FindStringSubmatch(match)[1]]
+		})
+	default:
+		return labels[qm.Legend]
+	}
+}
+
+/**
+ * Labels arguments for TS.MRANGE, TS.MREVRANGE and TS.MGET
+ */
+func tsLabelsArgs(qm queryModel) []interface{} {
+	if qm.SelectedLabels == "" {
+		return []interface{}{"WITHLABELS"}
+	}
+
+	// Return only selected labels
+	args := []interface{}{"SELECTED_LABELS"}
+	for _, label := range strings.Fields(strings.ReplaceAll(qm.SelectedLabels, ",", " ")) {
+		args = append(args, label)
+	}
+
+	return args
+}
+
+/**
+ * Parse time-series sample with timestamp and value
+ */
+func parseTsSample(kvPair []interface{}) tsSample {
+	var k int64
+	var v float64
+
+	// Key
+	switch kvPair[0].(type) {
+	case []byte:
+		k, _ = strconv.ParseInt(string(kvPair[0].([]byte)), 10, 64)
+	default:
+		k = kvPair[0].(int64)
+	}
+
+	// Value
+	switch kvPair[1].(type) {
+	case []byte:
+		v, _ = strconv.ParseFloat(string(kvPair[1].([]byte)), 64)
+	default:
+		v, _ = strconv.ParseFloat(kvPair[1].(string), 64)
+	}
+
+	return tsSample{timestamp: k, value: &v}
+}
+
+/**
+ * Time-series sample, value is nil for missing intervals
+ */
+type tsSample struct {
+	timestamp int64
+	value     *float64
+}
+
+/**
+ * Reverse samples returned by TS.REVRANGE and TS.MREVRANGE
+ */
+func reverseTsSamples(samples []tsSample) {
+	for i, j := 0, len(samples)-1; i < j; i, j = i+1, j-1 {
+		samples[i], samples[j] = samples[j], samples[i]
+	}
+}
+
+/**
+ * Fill missing intervals between samples and across the time range
+ *
+ * Empty buckets reported as NaN are filled as well.
+ */
+func fillTsSamples(from int64, to int64, qm queryModel, samples []tsSample) ([]tsSample, error) {
+	if !qm.Fill || qm.Bucket <= 0 {
+		return samples, nil
+	}
 
-			// Fill missing intervals
-			if qm.Fill && qm.Bucket != 0 {
-				if !prevTime.IsZero() {
-					for ts.Sub(prevTime) > time.Duration(qm.Bucket)*time.Millisecond {
-						prevTime = prevTime.Add(time.Duration(qm.Bucket) * time.Millisecond)
-						frame.AppendRow(prevTime, float64(0))
-					}
+	bucket := int64(qm.Bucket)
+
+	// Fill across the time range if provided
+	withRange := to > from
+	if withRange && (to-from)/bucket > models.TimeSeriesFillMaxPoints {
+		return nil, fmt.Errorf("time bucket is too small to fill more than %d intervals", models.TimeSeriesFillMaxPoints)
+	}
+
+	var filled []tsSample
+
+	// Leading intervals from the start of the time range
+	if withRange {
+		var start int64
+		switch {
+		case qm.Align == "start" || qm.Align == "-":
+			// Buckets are aligned to the start of the time range
+			start = from
+		case len(samples) > 0:
+			// Align to the first sample
+			start = samples[0].timestamp - (samples[0].timestamp-from)/bucket*bucket
+		default:
+			start = from - from%bucket
+			if start < from {
+				start += bucket
+			}
+		}
+
+		for ts := start; ts <= to && (len(samples) == 0 || ts < samples[0].timestamp); ts += bucket {
+			filled = append(filled, tsSample{timestamp: ts})
+		}
+	}
+
+	// Intervals between samples
+	for i, sample := range samples {
+		if i > 0 {
+			for ts := samples[i-1].timestamp + bucket; ts < sample.timestamp; ts += bucket {
+				filled = append(filled, tsSample{timestamp: ts})
+			}
+		}
+
+		if sample.value != nil && math.IsNaN(*sample.value) {
+			sample.value = nil
+		}
+
+		filled = append(filled, sample)
+	}
+
+	// Trailing intervals to the end of the time range
+	if withRange && len(samples) > 0 {
+		for ts := samples[len(samples)-1].timestamp + bucket; ts <= to; ts += bucket {
+			filled = append(filled, tsSample{timestamp: ts})
+		}
+	}
+
+	// Fill values
+	switch qm.FillMode {
+	case models.TimeSeriesFillNull:
+	case models.TimeSeriesFillPrevious:
+		var previous *float64
+		for i := range filled {
+			if filled[i].value == nil {
+				filled[i].value = previous
+			}
+			previous = filled[i].value
+		}
+	case models.TimeSeriesFillLinear:
+		prev := -1
+		for i := range filled {
+			if filled[i].value == nil {
+				continue
+			}
+
+			// Interpolate between previous and current samples
+			if prev >= 0 && i-prev > 1 {
+				x0, y0 := filled[prev].timestamp, *filled[prev].value
+				x1, y1 := filled[i].timestamp, *filled[i].value
+				for j := prev + 1; j < i; j++ {
+					v := y0 + (y1-y0)*float64(filled[j].timestamp-x0)/float64(x1-x0)
+					filled[j].value = &v
 				}
+			}
+			prev = i
+		}
+	default:
+		value := float64(0)
+		if qm.FillMode == models.TimeSeriesFillValue {
+			value = qm.FillValue
+		}
 
-				prevTime = ts
+		for i := range filled {
+			if filled[i].value == nil {
+				v := value
+				filled[i].value = &v
 			}
+		}
+	}
+
+	return filled, nil
+}
+
+/**
+ * Create data frame from time-series samples
+ *
+ * Values are nullable if missing intervals are not filled with a value or there are missing values.
+ */
+func createTsFrame(name string, valueName string, labels data.Labels, samples []tsSample, qm queryModel) *data.Frame {
+	nullable := qm.Fill && (qm.FillMode == models.TimeSeriesFillNull || qm.FillMode == models.TimeSeriesFillPrevious ||
+		qm.FillMode == models.TimeSeriesFillLinear)
+
+	times := make([]time.Time, len(samples))
+	for i, sample := range samples {
+		times[i] = time.Unix(0, sample.timestamp*int64(time.Millisecond))
+		nullable = nullable || sample.value == nil
+	}
+
+	// Nullable values
+	if nullable {
+		values := make([]*float64, len(samples))
+		for i, sample := range samples {
+			values[i] = sample.value
+		}
+
+		return data.NewFrame(name, data.NewField("time", nil, times), data.NewField(valueName, labels, values))
+	}
+
+	values := make([]float64, len(samples))
+	for i, sample := range samples {
+		values[i] = *sample.value
+	}
 
-			// Value
-			switch kvPair[1].(type) {
-			case []byte:
-				v, _ = strconv.ParseFloat(string(kvPair[1].([]byte)), 64)
-			default:
-				v, _ = strconv.ParseFloat(kvPair[1].(string), 64)
+	return data.NewFrame(name, data.NewField("time", nil, times), data.NewField(valueName, labels, values))
+}
+
+/**
+ * Time-series returned by TS.MRANGE and TS.MREVRANGE
+ */
+type tsSeries struct {
+	name    string
+	value   string
+	labels  map[string]string
+	samples []tsSample
+}
+
+/**
+ * Create frames for multiple time-series in the requested output format
+ */
+func createTsSeriesFrames(series []tsSeries, qm queryModel) data.Frames {
+	switch qm.TsFormat {
+	case models.TimeSeriesFormatWide:
+		return data.Frames{createTsWideFrame(qm.Command, series, qm)}
+	case models.TimeSeriesFormatLong:
+		return data.Frames{createTsLongFrame(qm.Command, series, qm)}
+	}
+
+	var frames data.Frames
+	for _, s := range series {
+		labels := s.labels
+
+		// Return labels if legend is not specified
+		if qm.Legend != "" {
+			labels = nil
+		}
+
+		frames = append(frames, createTsFrame(s.name, s.value, labels, s.samples, qm))
+	}
+
+	return frames
+}
+
+/**
+ * Create a single wide frame with time-series joined on time
+ *
+ * @see https://grafana.com/developers/dataplane/timeseries#time-series-wide-format-timeserieswide
+ */
+func createTsWideFrame(name string, series []tsSeries, qm queryModel) *data.Frame {
+	// Sorted unique timestamps
+	index := map[int64]int{}
+	var timestamps []int64
+	for _, s := range series {
+		for _, sample := range s.samples {
+			if _, ok := index[sample.timestamp]; !ok {
+				index[sample.timestamp] = 0
+				timestamps = append(timestamps, sample.timestamp)
 			}
+		}
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+
+	times := make([]time.Time, len(timestamps))
+	for i, ts := range timestamps {
+		index[ts] = i
+		times[i] = time.Unix(0, ts*int64(time.Millisecond))
+	}
 
-			// Append Row to Frame
-			frame.AppendRow(ts, v)
+	frame := data.NewFrame(name, data.NewField("time", nil, times))
+	frame.Meta = &data.FrameMeta{Type: data.FrameTypeTimeSeriesWide}
+
+	// Value field for each time-series
+	for _, s := range series {
+		values := make([]*float64, len(timestamps))
+		for _, sample := range s.samples {
+			values[index[sample.timestamp]] = sample.value
+		}
+
+		valueName := s.value
+		if valueName == "" {
+			valueName = "value"
 		}
 
-		// add the frames to the response
-		response.Frames = append(response.Frames, frame)
+		field := data.NewField(valueName, s.labels, values)
+		field.Config = &data.FieldConfig{DisplayNameFromDS: s.name}
+
+		// Return labels if legend is not specified
+		if qm.Legend != "" {
+			field.Labels = nil
+		}
+
+		frame.Fields = append(frame.Fields, field)
 	}
 
-	// Return Response
-	return response
+	return frame
+}
+
+/**
+ * Create a single long frame with a column for each label
+ *
+ * Time-series name is returned as a column if there are no labels.
+ * TS.MGET returns a table with a row for each time-series, time and value are null without samples.
+ * @see https://grafana.com/developers/dataplane/timeseries#time-series-long-format-timeserieslong-sql-like
+ */
+func createTsLongFrame(name string, series []tsSeries, qm queryModel) *data.Frame {
+	// Sorted label names
+	var keys []string
+	exists := map[string]bool{}
+	for _, s := range series {
+		for key := range s.labels {
+			if !exists[key] {
+				exists[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	sort.Strings(keys)
+
+	// Rows
+	type tsRow struct {
+		series *tsSeries
+		sample tsSample
+	}
+
+	latest := qm.Command == models.TimeSeriesMGet
+
+	var rows []tsRow
+	var empty []tsRow
+	for i := range series {
+		if latest && len(series[i].samples) == 0 {
+			empty = append(empty, tsRow{series: &series[i]})
+			continue
+		}
+
+		for _, sample := range series[i].samples {
+			rows = append(rows, tsRow{series: &series[i], sample: sample})
+		}
+	}
+
+	// Sort by time, time-series are kept in the returned order and time-series without samples are last
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].sample.timestamp < rows[j].sample.timestamp })
+	samples := len(rows)
+	rows = append(rows, empty...)
+
+	values := make([]*float64, len(rows))
+	for i, row := range rows {
+		values[i] = row.sample.value
+	}
+
+	var frame *data.Frame
+	if latest {
+		times := make([]*time.Time, len(rows))
+		for i := 0; i < samples; i++ {
+			ts := time.Unix(0, rows[i].sample.timestamp*int64(time.Millisecond))
+			times[i] = &ts
+		}
+
+		frame = data.NewFrame(name, data.NewField("time", nil, times))
+	} else {
+		times := make([]time.Time, len(rows))
+		for i, row := range rows {
+			times[i] = time.Unix(0, row.sample.timestamp*int64(time.Millisecond))
+		}
+
+		frame = data.NewFrame(name, data.NewField("time", nil, times))
+		frame.Meta = &data.FrameMeta{Type: data.FrameTypeTimeSeriesLong}
+	}
+
+	// Dimensions
+	if len(keys) == 0 {
+		names := make([]string, len(rows))
+		for i, row := range rows {
+			names[i] = row.series.name
+		}
+		frame.Fields = append(frame.Fields, data.NewField("name", nil, names))
+	}
+
+	for _, key := range keys {
+		labels := make([]string, len(rows))
+		for i, row := range rows {
+			labels[i] = row.series.labels[key]
+		}
+		frame.Fields = append(frame.Fields, data.NewField(key, nil, labels))
+	}
+
+	// Values
+	valueName := qm.Value
+	if valueName == "" {
+		valueName = "value"
+	}
+
+	frame.Fields = append(frame.Fields, data.NewField(valueName, nil, values))
+
+	return frame
 }
 
 /**
@@ -259,16 +788,23 @@ func queryTsGet(qm queryModel, client redisClient) backend.DataResponse {
 }
 
 /**
- * TS.INFO key
+ * TS.INFO key [DEBUG]
  *
- * @see https://oss.redislabs.com/redistimeseries/1.4/commands/#tsinfo
+ * Chunks and compaction rules are returned as separate frames in DEBUG mode.
+ * @see https://redis.io/commands/ts.info/
  */
 func queryTsInfo(qm queryModel, client redisClient) backend.DataResponse {
 	response := backend.DataResponse{}
 
 	// Execute command
 	var result map[string]interface{}
-	err := client.RunCmd(&result, qm.Command, qm.Key)
+	var err error
+
+	if qm.Debug {
+		err = client.RunCmd(&result, qm.Command, qm.Key, "DEBUG")
+	} else {
+		err = client.RunCmd(&result, qm.Command, qm.Key)
+	}
 
 	// Check error
 	if err != nil {
@@ -280,6 +816,11 @@ func queryTsInfo(qm queryModel, client redisClient) backend.DataResponse {
 
 	// Add fields and values
 	for key := range result {
+		// Chunks and rules frames
+		if qm.Debug && (key == "Chunks" || key == "rules") {
+			continue
+		}
+
 		// Value
 		switch value := result[key].(type) {
 		case int64:
@@ -313,10 +854,84 @@ func queryTsInfo(qm queryModel, client redisClient) backend.DataResponse {
 	// Add the frame to the response
 	response.Frames = append(response.Frames, frame)
 
+	// Chunks and rules
+	if qm.Debug {
+		chunks, _ := result["Chunks"].([]interface{})
+		rules, _ := result["rules"].([]interface{})
+		response.Frames = append(response.Frames, createTsChunksFrame(chunks), createTsRulesFrame(rules))
+	}
+
 	// Return Response
 	return response
 }
 
+/**
+ * Create frame for chunks returned by TS.INFO DEBUG
+ */
+func createTsChunksFrame(chunks []interface{}) *data.Frame {
+	frame := data.NewFrame("chunks",
+		data.NewField("Start", nil, []time.Time{}),
+		data.NewField("End", nil, []time.Time{}),
+		data.NewField("Samples", nil, []int64{}),
+		data.NewField("Size", nil, []int64{}),
+		data.NewField("Bytes per sample", nil, []float64{}))
+	frame.Fields[3].Config = &data.FieldConfig{Unit: "decbytes"}
+	frame.Fields[4].Config = &data.FieldConfig{Unit: "decbytes"}
+
+	for _, chunk := range chunks {
+		values, ok := chunk.([]interface{})
+		if !ok {
+			log.DefaultLogger.Error(models.TimeSeriesInfo, "Conversion Error", "Unsupported Chunk type")
+			continue
+		}
+
+		fields := parseMapReply(values)
+		start, _ := strconv.ParseInt(replyToString(fields["startTimestamp"]), 10, 64)
+		end, _ := strconv.ParseInt(replyToString(fields["endTimestamp"]), 10, 64)
+		samples, _ := strconv.ParseInt(replyToString(fields["samples"]), 10, 64)
+		size, _ := strconv.ParseInt(replyToString(fields["size"]), 10, 64)
+		bytesPerSample, _ := strconv.ParseFloat(replyToString(fields["bytesPerSample"]), 64)
+
+		frame.AppendRow(time.Unix(0, start*int64(time.Millisecond)), time.Unix(0, end*int64(time.Millisecond)), samples, size, bytesPerSample)
+	}
+
+	return frame
+}
+
+/**
+ * Create frame for compaction rules returned by TS.INFO
+ *
+ * Each rule is returned as destination key, time bucket, aggregation and alignment timestamp since 1.8.
+ */
+func createTsRulesFrame(rules []interface{}) *data.Frame {
+	frame := data.NewFrame("rules",
+		data.NewField("Key", nil, []string{}),
+		data.NewField("Bucket", nil, []int64{}),
+		data.NewField("Aggregation", nil, []string{}),
+		data.NewField("Align", nil, []int64{}))
+	frame.Fields[1].Config = &data.FieldConfig{Unit: "ms"}
+	frame.Fields[3].Config = &data.FieldConfig{Unit: "ms"}
+
+	for _, rule := range rules {
+		values, ok := rule.([]interface{})
+		if !ok || len(values) < 3 {
+			log.DefaultLogger.Error(models.TimeSeriesInfo, "Conversion Error", "Unsupported Rule type")
+			continue
+		}
+
+		bucket, _ := strconv.ParseInt(replyToString(values[1]), 10, 64)
+
+		var align int64
+		if len(values) > 3 {
+			align, _ = strconv.ParseInt(replyToString(values[3]), 10, 64)
+		}
+
+		frame.AppendRow(replyToString(values[0]), bucket, replyToString(values[2]), align)
+	}
+
+	return frame
+}
+
 /**
  * TS.QUERYINDEX filter...
  *
@@ -355,9 +970,9 @@ func queryTsQueryIndex(qm queryModel, client redisClient) backend.DataResponse {
 }
 
 /**
- * TS.MGET [WITHLABELS] FILTER filter...
+ * TS.MGET [WITHLABELS | SELECTED_LABELS label...] FILTER filter...
  *
- * @see https://oss.redislabs.com/redistimeseries/commands/#tsmget
+ * @see https://redis.io/commands/ts.mget/
  */
 func queryTsMGet(qm queryModel, client redisClient) backend.DataResponse {
 	response := backend.DataResponse{}
@@ -373,7 +988,9 @@ func queryTsMGet(qm queryModel, client redisClient) backend.DataResponse {
 
 	// Execute command
 	var result interface{}
-	err := client.RunFlatCmd(&result, qm.Command, "WITHLABELS", "FILTER", filter)
+	args := tsLabelsArgs(qm)
+	args = append(args, "FILTER", filter)
+	err := client.RunFlatCmd(&result, qm.Command, args[0].(string), args[1:]...)
 
 	// Check error
 	if err != nil {
@@ -389,26 +1006,15 @@ func queryTsMGet(qm queryModel, client redisClient) backend.DataResponse {
 	}
 
 	// Parse Time-Series data
+	var series []tsSeries
 	for _, innerArray := range result.([]interface{}) {
 		tsArrReply := innerArray.([]interface{})
 
 		// Labels
-		labelsRaw := tsArrReply[1].([]interface{})
-		labels := make(map[string]string, len(labelsRaw))
-
-		// Parse Labels
-		for _, labelRaw := range labelsRaw {
-			kvPair := labelRaw.([]interface{})
-			k := string(kvPair[0].([]byte))
-			v := string(kvPair[1].([]byte))
-			labels[k] = v
-		}
+		labels := parseTsLabels(tsArrReply[1].([]interface{}))
 
 		// Use Time-series's name as Legend if Legend label is not specified
-		legend := string(tsArrReply[0].([]byte))
-		if qm.Legend != "" {
-			legend = labels[qm.Legend]
-		}
+		legend := tsLegend(string(tsArrReply[0].([]byte)), labels, qm)
 
 		// Use value's label if specified
 		value := ""
@@ -416,51 +1022,18 @@ func queryTsMGet(qm queryModel, client redisClient) backend.DataResponse {
 			value = labels[qm.Value]
 		}
 
-		// Create Frame
-		frame := data.NewFrame(legend,
-			data.NewField("time", nil, []time.Time{}))
-
-		// Return labels if legend is not specified
-		if qm.Legend != "" {
-			frame.Fields = append(frame.Fields,
-				data.NewField(value, nil, []float64{}),
-			)
-		} else {
-			frame.Fields = append(frame.Fields,
-				data.NewField(value, labels, []float64{}),
-			)
+		// Latest sample, empty for time-series without samples
+		var samples []tsSample
+		if kvPair := tsArrReply[2].([]interface{}); len(kvPair) == 2 {
+			samples = append(samples, parseTsSample(kvPair))
 		}
 
-		// Values
-		kvPair := tsArrReply[2].([]interface{})
-		var k int64
-		var v float64
-
-		// Key
-		switch kvPair[0].(type) {
-		case []byte:
-			k, _ = strconv.ParseInt(string(kvPair[0].([]byte)), 10, 64)
-		default:
-			k = kvPair[0].(int64)
-		}
-
-		ts := time.Unix(0, k*int64(time.Millisecond))
-
-		// Value
-		switch kvPair[1].(type) {
-		case []byte:
-			v, _ = strconv.ParseFloat(string(kvPair[1].([]byte)), 64)
-		default:
-			v, _ = strconv.ParseFloat(kvPair[1].(string), 64)
-		}
-
-		// Append Row to Frame
-		frame.AppendRow(ts, v)
-
-		// add the frames to the response
-		response.Frames = append(response.Frames, frame)
+		series = append(series, tsSeries{name: legend, value: value, labels: labels, samples: samples})
 	}
 
+	// Add the frames to the response
+	response.Frames = append(response.Frames, createTsSeriesFrames(series, qm)...)
+
 	// Return Response
 	return response
 }
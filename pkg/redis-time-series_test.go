@@ -2,9 +2,12 @@ package main
 
 import (
 	"errors"
+	"math"
 	"testing"
 	"time"
 
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
 	"github.com/redisgrafana/grafana-redis-datasource/pkg/models"
 	"github.com/stretchr/testify/require"
 )
@@ -127,6 +130,28 @@ func TestQueryTsRange(t *testing.T) {
 			}
 		})
 	}
+
+	// Reverse range
+	t.Run("should pass count and return reverse range in ascending order", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv: [][]string{
+				{"1548149195000", "27.399999999999999"},
+				{"1548149190000", "24.800000000000001"},
+				{"1548149185000", "26.199999999999999"},
+			},
+			expectedCmd:  models.TimeSeriesRevRange,
+			expectedFlat: []interface{}{"test1", int64(0), int64(1548149195000), "COUNT", 3, "AGGREGATION", "avg", 5000},
+		}
+
+		response := queryTsRange(0, 1548149195000, queryModel{Command: models.TimeSeriesRevRange, Key: "test1", Count: 3, Aggregation: "avg", Bucket: 5000}, &client)
+		require.NoError(t, response.Error)
+		require.Equal(t, 3, response.Frames[0].Fields[0].Len())
+		require.Equal(t, time.Unix(0, 1548149185000*int64(time.Millisecond)), response.Frames[0].Fields[0].At(0))
+		require.Equal(t, 26.2, response.Frames[0].Fields[1].At(0))
+		require.Equal(t, time.Unix(0, 1548149195000*int64(time.Millisecond)), response.Frames[0].Fields[0].At(2))
+	})
 }
 
 func TestQueryTsMRange(t *testing.T) {
@@ -467,6 +492,32 @@ func TestQueryTsMRange(t *testing.T) {
 			}
 		})
 	}
+
+	// Reverse multi range
+	t.Run("should pass count and return reverse multi range in ascending order", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv: []interface{}{
+				[]interface{}{
+					[]byte("temperature:2:32"),
+					[]interface{}{},
+					[]interface{}{
+						[]interface{}{int64(1548149195000), []byte("27.399999999999999")},
+						[]interface{}{int64(1548149180000), []byte("26.199999999999999")},
+					},
+				},
+			},
+			expectedCmd:  models.TimeSeriesMRevRange,
+			expectedFlat: []interface{}{"0", int64(1548149195000), "COUNT", 2, "WITHLABELS", "FILTER", []string{"area_id=32"}},
+		}
+
+		response := queryTsMRange(0, 1548149195000, queryModel{Command: models.TimeSeriesMRevRange, Count: 2, Filter: "area_id=32"}, &client)
+		require.NoError(t, response.Error)
+		require.Equal(t, 2, response.Frames[0].Fields[0].Len())
+		require.Equal(t, time.Unix(0, 1548149180000*int64(time.Millisecond)), response.Frames[0].Fields[0].At(0))
+		require.Equal(t, 27.4, response.Frames[0].Fields[1].At(1))
+	})
 }
 
 func TestQueryTsGet(t *testing.T) {
@@ -855,3 +906,555 @@ func TestQueryTsMGet(t *testing.T) {
 		})
 	}
 }
+
+/**
+ * Range options
+ */
+func TestTsRangeOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		qm            queryModel
+		expected      []interface{}
+		expectedError string
+	}{
+		{
+			"should return no options",
+			queryModel{},
+			nil,
+			"",
+		},
+		{
+			"should ignore aggregation options without aggregation",
+			queryModel{Align: "start", BucketTimestamp: "+", Empty: true},
+			nil,
+			"",
+		},
+		{
+			"should return all options in order",
+			queryModel{Latest: true, FilterByTs: "1000, 2000 3000", FilterByValueMin: "-10", FilterByValueMax: "10.5", Count: 10,
+				Align: "end", Aggregation: "avg", Bucket: 5000, BucketTimestamp: "~", Empty: true},
+			[]interface{}{"LATEST", "FILTER_BY_TS", "1000", "2000", "3000", "FILTER_BY_VALUE", "-10", "10.5", "COUNT", 10,
+				"ALIGN", "end", "AGGREGATION", "avg", 5000, "BUCKETTIMESTAMP", "~", "EMPTY"},
+			"",
+		},
+		{
+			"should validate timestamps",
+			queryModel{FilterByTs: "1000 now"},
+			nil,
+			"filter by timestamp is not valid: now",
+		},
+		{
+			"should require minimum and maximum values",
+			queryModel{FilterByValueMin: "10"},
+			nil,
+			"filter by value requires minimum and maximum values",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			options, err := tsRangeOptions(tt.qm)
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, options)
+		})
+	}
+
+	// Range
+	t.Run("should pass options to the range command", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:          [][]string{{"1548149180000", "NaN"}},
+			expectedFlat: []interface{}{"test1", int64(0), int64(1000), "LATEST", "AGGREGATION", "max", 100, "EMPTY"},
+		}
+
+		response := queryTsRange(0, 1000, queryModel{Command: models.TimeSeriesRange, Key: "test1", Latest: true, Aggregation: "max", Bucket: 100, Empty: true}, &client)
+		require.NoError(t, response.Error)
+		require.True(t, math.IsNaN(response.Frames[0].Fields[1].At(0).(float64)), "Empty bucket should be returned as NaN")
+	})
+
+	// Multi range
+	t.Run("should pass options to the multi range command", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv:          []interface{}{},
+			expectedFlat: []interface{}{"0", int64(1000), "FILTER_BY_VALUE", "1", "2", "WITHLABELS", "FILTER", []string{"area_id=32"}},
+		}
+
+		response := queryTsMRange(0, 1000, queryModel{Command: models.TimeSeriesMRange, Filter: "area_id=32", FilterByValueMin: "1", FilterByValueMax: "2"}, &client)
+		require.NoError(t, response.Error)
+
+		response = queryTsMRange(0, 1000, queryModel{Command: models.TimeSeriesMRange, Filter: "area_id=32", FilterByTs: "abc"}, &client)
+		require.EqualError(t, response.Error, "filter by timestamp is not valid: abc")
+	})
+}
+
+/**
+ * Fill missing intervals
+ */
+func TestFillTsSamples(t *testing.T) {
+	t.Parallel()
+
+	value := func(v float64) *float64 { return &v }
+	samples := func() []tsSample {
+		return []tsSample{
+			{timestamp: 2000, value: value(10)},
+			{timestamp: 5000, value: value(40)},
+			{timestamp: 6000, value: value(math.NaN())},
+			{timestamp: 7000, value: value(20)},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		qm            queryModel
+		from          int64
+		to            int64
+		expected      []*float64
+		expectedFirst int64
+		expectedError string
+	}{
+		{
+			"should not fill without fill option",
+			queryModel{Bucket: 1000},
+			0,
+			9000,
+			[]*float64{value(10), value(40), value(math.NaN()), value(20)},
+			2000,
+			"",
+		},
+		{
+			"should fill with zero by default",
+			queryModel{Fill: true, Bucket: 1000},
+			0,
+			0,
+			[]*float64{value(10), value(0), value(0), value(40), value(0), value(20)},
+			2000,
+			"",
+		},
+		{
+			"should fill with null across the time range",
+			queryModel{Fill: true, FillMode: models.TimeSeriesFillNull, Bucket: 1000},
+			500,
+			8500,
+			[]*float64{nil, value(10), nil, nil, value(40), nil, value(20), nil},
+			1000,
+			"",
+		},
+		{
+			"should fill with previous value",
+			queryModel{Fill: true, FillMode: models.TimeSeriesFillPrevious, Bucket: 1000},
+			1000,
+			8000,
+			[]*float64{nil, value(10), value(10), value(10), value(40), value(40), value(20), value(20)},
+			1000,
+			"",
+		},
+		{
+			"should fill with linear interpolation",
+			queryModel{Fill: true, FillMode: models.TimeSeriesFillLinear, Bucket: 1000},
+			1000,
+			8000,
+			[]*float64{nil, value(10), value(20), value(30), value(40), value(30), value(20), nil},
+			1000,
+			"",
+		},
+		{
+			"should fill with constant value",
+			queryModel{Fill: true, FillMode: models.TimeSeriesFillValue, FillValue: -1, Bucket: 1000},
+			0,
+			0,
+			[]*float64{value(10), value(-1), value(-1), value(40), value(-1), value(20)},
+			2000,
+			"",
+		},
+		{
+			"should limit number of intervals",
+			queryModel{Fill: true, Bucket: 1},
+			0,
+			models.TimeSeriesFillMaxPoints + 10,
+			nil,
+			0,
+			"time bucket is too small to fill more than 100000 intervals",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filled, err := fillTsSamples(tt.from, tt.to, tt.qm, samples())
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, filled, len(tt.expected))
+			require.Equal(t, tt.expectedFirst, filled[0].timestamp)
+
+			for i, sample := range filled {
+				if tt.expected[i] == nil {
+					require.Nilf(t, sample.value, "Invalid value at %v", i)
+				} else if math.IsNaN(*tt.expected[i]) {
+					require.Truef(t, math.IsNaN(*sample.value), "Invalid value at %v", i)
+				} else {
+					require.InDeltaf(t, *tt.expected[i], *sample.value, 0.0001, "Invalid value at %v", i)
+				}
+			}
+		})
+	}
+
+	// Empty range
+	t.Run("should fill empty series across the time range", func(t *testing.T) {
+		t.Parallel()
+
+		filled, err := fillTsSamples(1500, 4000, queryModel{Fill: true, FillMode: models.TimeSeriesFillNull, Bucket: 1000}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []tsSample{{timestamp: 2000}, {timestamp: 3000}, {timestamp: 4000}}, filled)
+	})
+
+	// Aligned to the start
+	t.Run("should fill from the start of the time range with samples", func(t *testing.T) {
+		t.Parallel()
+
+		filled, err := fillTsSamples(250, 4250, queryModel{Fill: true, FillMode: models.TimeSeriesFillNull, Bucket: 1000, Align: "start"},
+			[]tsSample{{timestamp: 1250, value: value(1)}, {timestamp: 3250, value: value(3)}})
+		require.NoError(t, err)
+		require.Equal(t, []tsSample{{timestamp: 250}, {timestamp: 1250, value: value(1)}, {timestamp: 2250}, {timestamp: 3250, value: value(3)}, {timestamp: 4250}}, filled)
+	})
+
+	// Nullable frames
+	t.Run("should return nullable values for multi range", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv: []interface{}{
+				[]interface{}{
+					[]byte("temperature:2:32"),
+					[]interface{}{},
+					[]interface{}{
+						[]interface{}{int64(1000), []byte("1")},
+						[]interface{}{int64(3000), []byte("3")},
+					},
+				},
+			},
+		}
+
+		response := queryTsMRange(0, 4000, queryModel{Command: models.TimeSeriesMRange, Filter: "area_id=32", Fill: true, FillMode: models.TimeSeriesFillLinear, Bucket: 1000}, &client)
+		require.NoError(t, response.Error)
+		require.Equal(t, 5, response.Frames[0].Fields[0].Len())
+		require.Nil(t, response.Frames[0].Fields[1].At(0))
+		require.Equal(t, 2.0, *response.Frames[0].Fields[1].At(2).(*float64))
+		require.Nil(t, response.Frames[0].Fields[1].At(4))
+	})
+}
+
+/**
+ * Automatic time bucket
+ */
+func TestTsBucket(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tests := []struct {
+		name     string
+		query    backend.DataQuery
+		qm       queryModel
+		expected int
+	}{
+		{
+			"should return time bucket if auto is disabled",
+			backend.DataQuery{Interval: time.Minute},
+			queryModel{Bucket: 5000},
+			5000,
+		},
+		{
+			"should round interval up",
+			backend.DataQuery{Interval: 40 * time.Second},
+			queryModel{AutoBucket: true, Bucket: 5000},
+			60000,
+		},
+		{
+			"should use maximum data points for the time range",
+			backend.DataQuery{Interval: time.Second, MaxDataPoints: 1000, TimeRange: backend.TimeRange{From: now.Add(-30 * 24 * time.Hour), To: now}},
+			queryModel{AutoBucket: true},
+			3600000,
+		},
+		{
+			"should round to days",
+			backend.DataQuery{MaxDataPoints: 10, TimeRange: backend.TimeRange{From: now.Add(-365 * 24 * time.Hour), To: now}},
+			queryModel{AutoBucket: true},
+			37 * 86400000,
+		},
+		{
+			"should use minimum time bucket",
+			backend.DataQuery{Interval: 20 * time.Millisecond, MaxDataPoints: 1000, TimeRange: backend.TimeRange{From: now.Add(-time.Second), To: now}},
+			queryModel{AutoBucket: true, MinBucket: 10000},
+			10000,
+		},
+		{
+			"should fall back to time bucket without interval and data points",
+			backend.DataQuery{},
+			queryModel{AutoBucket: true, Bucket: 5000},
+			5000,
+		},
+		{
+			"should fall back to minimum time bucket without interval and data points",
+			backend.DataQuery{},
+			queryModel{AutoBucket: true, Bucket: 5000, MinBucket: 60000},
+			60000,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expected, tsBucket(tt.query, tt.qm))
+		})
+	}
+}
+
+/**
+ * Legend
+ */
+func TestTsLegend(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{"region": "eu", "host": "node-1"}
+
+	tests := []struct {
+		name     string
+		legend   string
+		expected string
+	}{
+		{"should return time-series name", "", "temperature:1"},
+		{"should return label", "region", "eu"},
+		{"should render template", "{{region}}-{{ host }}", "eu-node-1"},
+		{"should render missing labels as empty", "{{region}}/{{unknown}}", "eu/"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expected, tsLegend("temperature:1", labels, queryModel{Legend: tt.legend}))
+		})
+	}
+
+	// Selected labels
+	t.Run("should request selected labels for multi range", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{
+			rcv: []interface{}{
+				[]interface{}{
+					[]byte("temperature:2:32"),
+					[]interface{}{[]interface{}{[]byte("region"), []byte("eu")}, []interface{}{[]byte("host"), nil}},
+					[]interface{}{[]interface{}{int64(1548149180000), []byte("26.2")}},
+				},
+			},
+			expectedFlat: []interface{}{"0", int64(1000), "SELECTED_LABELS", "region", "host", "FILTER", []string{"area_id=32"}},
+		}
+
+		response := queryTsMRange(0, 1000, queryModel{Command: models.TimeSeriesMRange, Filter: "area_id=32", SelectedLabels: "region, host", Legend: "{{region}}:{{host}}"}, &client)
+		require.NoError(t, response.Error)
+		require.Equal(t, "eu:", response.Frames[0].Name)
+		require.Nil(t, response.Frames[0].Fields[1].Labels)
+	})
+}
+
+/**
+ * Multi range output formats
+ */
+func TestQueryTsMRangeFormat(t *testing.T) {
+	t.Parallel()
+
+	rcv := func() interface{} {
+		return []interface{}{
+			[]interface{}{
+				[]byte("temperature:1"),
+				[]interface{}{[]interface{}{[]byte("region"), []byte("eu")}, []interface{}{[]byte("host"), []byte("a")}},
+				[]interface{}{
+					[]interface{}{int64(1000), []byte("1")},
+					[]interface{}{int64(3000), []byte("3")},
+				},
+			},
+			[]interface{}{
+				[]byte("temperature:2"),
+				[]interface{}{[]interface{}{[]byte("region"), []byte("us")}},
+				[]interface{}{
+					[]interface{}{int64(2000), []byte("20")},
+					[]interface{}{int64(3000), []byte("30")},
+				},
+			},
+		}
+	}
+
+	// Wide
+	t.Run("should return wide frame", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{rcv: rcv()}
+		response := queryTsMRange(0, 0, queryModel{Command: models.TimeSeriesMRange, Filter: "region!=", TsFormat: models.TimeSeriesFormatWide}, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 1)
+
+		frame := response.Frames[0]
+		require.Equal(t, data.FrameTypeTimeSeriesWide, frame.Meta.Type)
+		require.Len(t, frame.Fields, 3)
+		require.Equal(t, 3, frame.Fields[0].Len())
+		require.Equal(t, time.Unix(0, 2000*int64(time.Millisecond)), frame.Fields[0].At(1))
+		require.Equal(t, data.Labels{"region": "eu", "host": "a"}, frame.Fields[1].Labels)
+		require.Equal(t, "temperature:1", frame.Fields[1].Config.DisplayNameFromDS)
+		require.Nil(t, frame.Fields[1].At(1))
+		require.Equal(t, 3.0, *frame.Fields[1].At(2).(*float64))
+		require.Nil(t, frame.Fields[2].At(0))
+		require.Equal(t, 20.0, *frame.Fields[2].At(1).(*float64))
+	})
+
+	// Long
+	t.Run("should return long frame", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{rcv: rcv()}
+		response := queryTsMRange(0, 0, queryModel{Command: models.TimeSeriesMRange, Filter: "region!=", TsFormat: models.TimeSeriesFormatLong}, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 1)
+
+		frame := response.Frames[0]
+		require.Equal(t, data.FrameTypeTimeSeriesLong, frame.Meta.Type)
+		require.Len(t, frame.Fields, 4)
+		require.Equal(t, "host", frame.Fields[1].Name)
+		require.Equal(t, "region", frame.Fields[2].Name)
+		require.Equal(t, "value", frame.Fields[3].Name)
+		require.Equal(t, 4, frame.Fields[0].Len())
+
+		// Sorted by time
+		require.Equal(t, time.Unix(0, 2000*int64(time.Millisecond)), frame.Fields[0].At(1))
+		require.Equal(t, "us", frame.Fields[2].At(1))
+		require.Equal(t, "", frame.Fields[1].At(1))
+		require.Equal(t, "eu", frame.Fields[2].At(2))
+		require.Equal(t, 30.0, *frame.Fields[3].At(3).(*float64))
+	})
+}
+
+/**
+ * TS.MGET with selected labels and table
+ */
+func TestQueryTsMGetLabels(t *testing.T) {
+	t.Parallel()
+
+	rcv := func() interface{} {
+		return []interface{}{
+			[]interface{}{
+				[]byte("cpu:1"),
+				[]interface{}{[]interface{}{[]byte("host"), []byte("a")}},
+				[]interface{}{int64(1000), []byte("15")},
+			},
+			[]interface{}{
+				[]byte("cpu:2"),
+				[]interface{}{[]interface{}{[]byte("host"), []byte("b")}},
+				[]interface{}{},
+			},
+		}
+	}
+
+	// Multiple frames
+	t.Run("should return frame with labels for each time-series", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{rcv: rcv(), expectedFlat: []interface{}{"SELECTED_LABELS", "host", "FILTER", []string{"type=cpu"}}}
+		response := queryTsMGet(queryModel{Command: models.TimeSeriesMGet, Filter: "type=cpu", SelectedLabels: "host"}, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 2)
+		require.Equal(t, data.Labels{"host": "a"}, response.Frames[0].Fields[1].Labels)
+		require.Equal(t, 15.0, response.Frames[0].Fields[1].At(0))
+		require.Equal(t, 0, response.Frames[1].Fields[0].Len())
+	})
+
+	// Table
+	t.Run("should return single table with row for each time-series", func(t *testing.T) {
+		t.Parallel()
+
+		client := testClient{rcv: rcv(), expectedFlat: []interface{}{"WITHLABELS", "FILTER", []string{"type=cpu"}}}
+		response := queryTsMGet(queryModel{Command: models.TimeSeriesMGet, Filter: "type=cpu", TsFormat: models.TimeSeriesFormatLong}, &client)
+		require.NoError(t, response.Error)
+		require.Len(t, response.Frames, 1)
+		require.Len(t, response.Frames[0].Fields, 3)
+		require.Equal(t, 2, response.Frames[0].Fields[0].Len())
+		require.Equal(t, time.Unix(1, 0), *response.Frames[0].Fields[0].At(0).(*time.Time))
+		require.Equal(t, "a", response.Frames[0].Fields[1].At(0))
+		require.Equal(t, 15.0, *response.Frames[0].Fields[2].At(0).(*float64))
+		require.Nil(t, response.Frames[0].Fields[0].At(1))
+		require.Equal(t, "b", response.Frames[0].Fields[1].At(1))
+		require.Nil(t, response.Frames[0].Fields[2].At(1))
+	})
+}
+
+/**
+ * TS.INFO DEBUG
+ */
+func TestQueryTsInfoDebug(t *testing.T) {
+	t.Parallel()
+
+	client := testClient{
+		rcv: map[string]interface{}{
+			"totalSamples": int64(300),
+			"chunkCount":   int64(2),
+			"rules": []interface{}{
+				[]interface{}{[]byte("temperature:avg"), int64(60000), []byte("AVG"), int64(0)},
+				[]interface{}{[]byte("temperature:max"), int64(3600000), []byte("MAX")},
+			},
+			"Chunks": []interface{}{
+				[]interface{}{
+					[]byte("startTimestamp"), int64(1548149180000), []byte("endTimestamp"), int64(1548149279000),
+					[]byte("samples"), int64(100), []byte("size"), int64(4096), []byte("bytesPerSample"), []byte("40.96"),
+				},
+				[]interface{}{
+					[]byte("startTimestamp"), int64(1548149280000), []byte("endTimestamp"), int64(1548149479000),
+					[]byte("samples"), int64(200), []byte("size"), int64(4096), []byte("bytesPerSample"), []byte("20.48"),
+				},
+			},
+		},
+		expectedCmd:  models.TimeSeriesInfo,
+		expectedArgs: []string{"test1", "DEBUG"},
+	}
+
+	response := queryTsInfo(queryModel{Command: models.TimeSeriesInfo, Key: "test1", Debug: true}, &client)
+	require.NoError(t, response.Error)
+	require.Len(t, response.Frames, 3)
+	require.Len(t, response.Frames[0].Fields, 2, "Chunks and rules should not be added to the info frame")
+
+	// Chunks
+	chunks := response.Frames[1]
+	require.Equal(t, "chunks", chunks.Name)
+	require.Equal(t, 2, chunks.Fields[0].Len())
+	require.Equal(t, time.Unix(0, 1548149280000*int64(time.Millisecond)), chunks.Fields[0].At(1))
+	require.Equal(t, int64(200), chunks.Fields[2].At(1))
+	require.Equal(t, int64(4096), chunks.Fields[3].At(1))
+	require.Equal(t, 20.48, chunks.Fields[4].At(1))
+
+	// Rules
+	rules := response.Frames[2]
+	require.Equal(t, "rules", rules.Name)
+	require.Equal(t, 2, rules.Fields[0].Len())
+	require.Equal(t, "temperature:avg", rules.Fields[0].At(0))
+	require.Equal(t, int64(60000), rules.Fields[1].At(0))
+	require.Equal(t, "AVG", rules.Fields[2].At(0))
+	require.Equal(t, int64(3600000), rules.Fields[1].At(1))
+	require.Equal(t, int64(0), rules.Fields[3].At(1))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/grafana/grafana-plugin-sdk-go/backend"
 	"github.com/grafana/grafana-plugin-sdk-go/backend/instancemgmt"
@@ -16,12 +17,16 @@ import (
  */
 type testClient struct {
 	rcv          interface{}
+	cmdRcv       map[string]interface{}
 	batchRcv     [][]interface{}
 	batchErr     []error
+	nodesRcv     []nodeCommandResult
 	expectedArgs []string
+	expectedFlat []interface{}
 	expectedCmd  string
 	err          error
 	batchCalls   int
+	calls        []string
 	mock.Mock
 }
 
@@ -66,6 +71,17 @@ func (client *testClient) RunFlatCmd(rcv interface{}, cmd, key string, args ...i
 		return client.err
 	}
 
+	if client.expectedFlat != nil {
+		actual := append([]interface{}{key}, args...)
+		if !reflect.DeepEqual(actual, client.expectedFlat) {
+			return fmt.Errorf("expected args did not match actuall args\nExpected:%v\nActual:%v\n", client.expectedFlat, actual)
+		}
+	}
+
+	if client.expectedCmd != "" && client.expectedCmd != cmd {
+		return fmt.Errorf("incorrect command, Expected:%s - Actual: %s", client.expectedCmd, cmd)
+	}
+
 	assignReceiver(rcv, client.rcv)
 	return nil
 }
@@ -74,10 +90,27 @@ func (client *testClient) RunFlatCmd(rcv interface{}, cmd, key string, args ...i
  * Cmd()
  */
 func (client *testClient) RunCmd(rcv interface{}, cmd string, args ...string) error {
+	client.calls = append(client.calls, strings.Join(append([]string{cmd}, args...), " "))
+
 	if client.err != nil {
 		return client.err
 	}
 
+	// Receiver or error for the additional command with or without arguments
+	cmdRcv, ok := client.cmdRcv[strings.Join(append([]string{cmd}, args...), " ")]
+	if !ok {
+		cmdRcv, ok = client.cmdRcv[cmd]
+	}
+
+	if ok {
+		if err, ok := cmdRcv.(error); ok {
+			return err
+		}
+
+		assignReceiver(rcv, cmdRcv)
+		return nil
+	}
+
 	if client.expectedArgs != nil {
 		if !reflect.DeepEqual(args, client.expectedArgs) {
 			return fmt.Errorf("expected args did not match actuall args\nExpected:%s\nActual:%s\n", client.expectedArgs, args)
@@ -109,6 +142,24 @@ func (client *testClient) RunBatchFlatCmd(commands []flatCommandArgs) error {
 	return err
 }
 
+/**
+ * Command execution on all nodes
+ */
+func (client *testClient) RunNodesCmd(cmd string, args ...string) []nodeCommandResult {
+	if client.err != nil {
+		return []nodeCommandResult{{err: client.err}}
+	}
+
+	return client.nodesRcv
+}
+
+/**
+ * Command execution on the Sentinel
+ */
+func (client *testClient) RunSentinelCmd(rcv interface{}, cmd string, args ...string) error {
+	return client.RunCmd(rcv, cmd, args...)
+}
+
 /**
  * Receiver
  */
@@ -224,6 +275,20 @@ func (client *panickingClient) RunBatchFlatCmd(commands []flatCommandArgs) error
 	panic("Panic")
 }
 
+/**
+ * Nodes command
+ */
+func (client *panickingClient) RunNodesCmd(cmd string, args ...string) []nodeCommandResult {
+	panic("Panic")
+}
+
+/**
+ * Sentinel command
+ */
+func (client *panickingClient) RunSentinelCmd(rcv interface{}, cmd string, args ...string) error {
+	panic("Panic")
+}
+
 /**
  * Get
  */
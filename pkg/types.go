@@ -40,40 +40,116 @@ type dataModel struct {
  * Query Model
  */
 type queryModel struct {
-	Type              string   `json:"type"`
-	Query             string   `json:"query"`
-	Key               string   `json:"keyName"`
-	Field             string   `json:"field"`
-	Filter            string   `json:"filter"`
-	Command           string   `json:"command"`
-	Aggregation       string   `json:"aggregation"`
-	Bucket            int      `json:"bucket"`
-	Legend            string   `json:"legend"`
-	Value             string   `json:"value"`
-	Section           string   `json:"section"`
-	Size              int      `json:"size"`
-	Fill              bool     `json:"fill"`
-	Streaming         bool     `json:"streaming"`
-	StreamingDataType string   `json:"streamingDataType"`
-	CLI               bool     `json:"cli"`
-	Cursor            string   `json:"cursor"`
-	Match             string   `json:"match"`
-	Count             int      `json:"count"`
-	Samples           int      `json:"samples"`
-	Unblocking        bool     `json:"unblocking"`
-	Requirements      string   `json:"requirements"`
-	Start             string   `json:"start"`
-	End               string   `json:"end"`
-	Cypher            string   `json:"cypher"`
-	Min               string   `json:"min"`
-	Max               string   `json:"max"`
-	ZRangeQuery       string   `json:"zrangeQuery"`
-	Path              string   `json:"path"`
-	TsReducer         string   `json:"tsReducer"`
-	TsGroupByLabel    string   `json:"tsGroupByLabel"`
-	SearchQuery       string   `json:"searchQuery"`
-	SortBy            string   `json:"sortBy"`
-	SortDirection     string   `json:"sortDirection"`
-	Offset            int      `json:"offset"`
-	ReturnFields      []string `json:"returnFields"`
+	Type               string        `json:"type"`
+	Query              string        `json:"query"`
+	Key                string        `json:"keyName"`
+	Field              string        `json:"field"`
+	Filter             string        `json:"filter"`
+	Command            string        `json:"command"`
+	Aggregation        string        `json:"aggregation"`
+	Bucket             int           `json:"bucket"`
+	AutoBucket         bool          `json:"autoBucket"`
+	MinBucket          int           `json:"minBucket"`
+	Legend             string        `json:"legend"`
+	Value              string        `json:"value"`
+	Section            string        `json:"section"`
+	Size               int           `json:"size"`
+	Fill               bool          `json:"fill"`
+	FillMode           string        `json:"fillMode"`
+	FillValue          float64       `json:"fillValue"`
+	Streaming          bool          `json:"streaming"`
+	StreamingDataType  string        `json:"streamingDataType"`
+	CLI                bool          `json:"cli"`
+	Cursor             string        `json:"cursor"`
+	Match              string        `json:"match"`
+	Count              int           `json:"count"`
+	Samples            int           `json:"samples"`
+	Unblocking         bool          `json:"unblocking"`
+	Requirements       string        `json:"requirements"`
+	Start              string        `json:"start"`
+	End                string        `json:"end"`
+	Cypher             string        `json:"cypher"`
+	Min                string        `json:"min"`
+	Max                string        `json:"max"`
+	ZRangeQuery        string        `json:"zrangeQuery"`
+	Path               string        `json:"path"`
+	TsReducer          string        `json:"tsReducer"`
+	TsGroupByLabel     string        `json:"tsGroupByLabel"`
+	SelectedLabels     string        `json:"selectedLabels"`
+	TsFormat           string        `json:"tsFormat"`
+	Debug              bool          `json:"debug"`
+	Expression         string        `json:"expression"`
+	SearchQuery        string        `json:"searchQuery"`
+	SortBy             string        `json:"sortBy"`
+	SortDirection      string        `json:"sortDirection"`
+	Offset             int           `json:"offset"`
+	ReturnFields       []string      `json:"returnFields"`
+	CompareNodes       bool          `json:"compareNodes"`
+	FilterByTs         string        `json:"filterByTs"`
+	FilterByValueMin   string        `json:"filterByValueMin"`
+	FilterByValueMax   string        `json:"filterByValueMax"`
+	Align              string        `json:"align"`
+	Latest             bool          `json:"latest"`
+	Empty              bool          `json:"empty"`
+	BucketTimestamp    string        `json:"bucketTimestamp"`
+	SearchPipeline     []searchStep  `json:"searchPipeline"`
+	SearchTimeField    string        `json:"searchTimeField"`
+	SearchTimeUnit     string        `json:"searchTimeUnit"`
+	SearchTimeFilter   bool          `json:"searchTimeFilter"`
+	SearchParams       []searchParam `json:"searchParams"`
+	Dialect            int           `json:"dialect"`
+	SearchProfile      string        `json:"searchProfile"`
+	Prefix             string        `json:"prefix"`
+	Fuzzy              bool          `json:"fuzzy"`
+	Distance           int           `json:"distance"`
+	IncludeTerms       string        `json:"includeTerms"`
+	ExcludeTerms       string        `json:"excludeTerms"`
+	WithScores         bool          `json:"withScores"`
+	NoContent          bool          `json:"noContent"`
+	Verbatim           bool          `json:"verbatim"`
+	InKeys             []string      `json:"inKeys"`
+	InFields           []string      `json:"inFields"`
+	Highlight          bool          `json:"highlight"`
+	HighlightFields    []string      `json:"highlightFields"`
+	HighlightOpen      string        `json:"highlightOpen"`
+	HighlightClose     string        `json:"highlightClose"`
+	Summarize          bool          `json:"summarize"`
+	SummarizeFields    []string      `json:"summarizeFields"`
+	SummarizeFrags     int           `json:"summarizeFrags"`
+	SummarizeLen       int           `json:"summarizeLen"`
+	SummarizeSeparator string        `json:"summarizeSeparator"`
+	WithCursor         bool          `json:"withCursor"`
+	MaxIdle            int           `json:"maxIdle"`
+}
+
+/**
+ * RediSearch query parameter
+ */
+type searchParam struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+/**
+ * FT.AGGREGATE pipeline step
+ */
+type searchStep struct {
+	Type       string          `json:"type"`
+	Fields     []string        `json:"fields"`
+	Reducers   []searchReducer `json:"reducers"`
+	Expression string          `json:"expression"`
+	As         string          `json:"as"`
+	Offset     int             `json:"offset"`
+	Count      int             `json:"count"`
+	Max        int             `json:"max"`
+}
+
+/**
+ * FT.AGGREGATE GROUPBY reducer
+ */
+type searchReducer struct {
+	Function string   `json:"function"`
+	Args     []string `json:"args"`
+	As       string   `json:"as"`
 }
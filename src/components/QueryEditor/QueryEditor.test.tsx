@@ -4,6 +4,7 @@ import { RedisGraph } from 'redis/graph';
 import { SelectableValue } from '@grafana/data';
 import {
   AggregationValue,
+  FillModeValue,
   QueryTypeCli,
   QueryTypeValue,
   Redis,
@@ -14,7 +15,14 @@ import {
 } from '../../redis';
 import { getQuery } from '../../tests/utils';
 import { QueryEditor } from './QueryEditor';
-import { RediSearch } from '../../redis/search';
+import {
+  RediSearch,
+  SearchSteps,
+  SearchStepValue,
+  SearchParamTypes,
+  SearchParamTypeValue,
+  SearchProfileValue,
+} from '../../redis/search';
 import Adapter from '@wojtekmaj/enzyme-adapter-react-17';
 import act from 'react-dom/test-utils';
 
@@ -567,6 +575,1116 @@ describe('QueryEditor', () => {
     ]);
   });
 
+  /**
+   * FT.AGGREGATE time buckets
+   */
+  describe('Search time fields', () => {
+    runQueryFieldsTest([
+      {
+        name: 'searchTimeField',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSearchTimeFieldChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.REDIS, command: Redis.INFO },
+      },
+      {
+        name: 'searchTimeUnit',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSearchTimeUnitChange;
+          }),
+        type: 'radioButton',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.AGGREGATE,
+          searchTimeField: 'ts',
+        },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE },
+      },
+      {
+        name: 'autoBucket',
+        testName: 'autoBucket for FT.AGGREGATE',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onAutoBucketChange;
+          }),
+        type: 'switch',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.AGGREGATE,
+          searchTimeField: 'ts',
+        },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, searchTimeField: 'ts' },
+      },
+      {
+        name: 'bucket',
+        testName: 'bucket for FT.AGGREGATE',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onBucketChange;
+          }),
+        type: 'number',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.AGGREGATE,
+          searchTimeField: 'ts',
+        },
+        queryWhenHidden: {
+          refId: '',
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.AGGREGATE,
+          searchTimeField: 'ts',
+          autoBucket: true,
+        },
+      },
+      {
+        name: 'minBucket',
+        testName: 'minBucket for FT.AGGREGATE',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onMinBucketChange;
+          }),
+        type: 'number',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.AGGREGATE,
+          searchTimeField: 'ts',
+          autoBucket: true,
+        },
+        queryWhenHidden: {
+          refId: '',
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.AGGREGATE,
+          searchTimeField: 'ts',
+        },
+      },
+    ]);
+  });
+
+  /**
+   * FT.AGGREGATE pipeline
+   */
+  describe('Search pipeline', () => {
+    const getWrapper = (query: RedisQuery) =>
+      shallow<QueryEditor>(
+        <QueryEditor datasource={{} as any} query={query} onRunQuery={onRunQuery} onChange={onChange} />
+      );
+    const getField = (wrapper: ShallowComponent, label: string) =>
+      wrapper.findWhere((node) => {
+        return node.name() === 'FormField' && node.prop('label') === label;
+      });
+
+    it('Should not be shown for FT.SEARCH', () => {
+      const query = getQuery({ type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH });
+      const wrapper = getWrapper(query);
+      const testedComponent = wrapper.findWhere((node) => {
+        return node.prop('onClick') === wrapper.instance().onSearchStepAdd;
+      });
+      expect(testedComponent.exists()).not.toBeTruthy();
+    });
+
+    it('Should add step', () => {
+      const query = getQuery({ type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE });
+      const wrapper = getWrapper(query);
+      const testedComponent = wrapper.findWhere((node) => {
+        return node.prop('onClick') === wrapper.instance().onSearchStepAdd;
+      });
+      testedComponent.simulate('click');
+      expect(onChange).toHaveBeenCalledWith({ ...query, searchPipeline: [{ type: SearchStepValue.GROUPBY }] });
+    });
+
+    it('Should remove step', () => {
+      const query = getQuery({
+        type: QueryTypeValue.SEARCH,
+        command: RediSearch.AGGREGATE,
+        searchPipeline: [{ type: SearchStepValue.LOAD, fields: ['@price'] }],
+      });
+      const wrapper = getWrapper(query);
+      const testedComponent = wrapper.findWhere((node) => {
+        return node.prop('icon') === 'trash-alt';
+      });
+      testedComponent.simulate('click');
+      expect(onChange).toHaveBeenCalledWith({ ...query, searchPipeline: [] });
+    });
+
+    it('Should update step type', () => {
+      const query = getQuery({
+        type: QueryTypeValue.SEARCH,
+        command: RediSearch.AGGREGATE,
+        searchPipeline: [{ type: SearchStepValue.LOAD, fields: ['@price'] }],
+      });
+      const wrapper = getWrapper(query);
+      const testedComponent = wrapper.findWhere((node) => {
+        return node.prop('options') === SearchSteps;
+      });
+      testedComponent.simulate('change', { value: SearchStepValue.SORTBY });
+      expect(onChange).toHaveBeenCalledWith({
+        ...query,
+        searchPipeline: [{ type: SearchStepValue.SORTBY, fields: ['@price'] }],
+      });
+    });
+
+    it('Should keep fields as typed', () => {
+      const query = getQuery({
+        type: QueryTypeValue.SEARCH,
+        command: RediSearch.AGGREGATE,
+        searchPipeline: [{ type: SearchStepValue.SORTBY, fields: ['@country', ' @count '] }],
+      });
+      const wrapper = getWrapper(query);
+      const testedComponent = getField(wrapper, 'Fields');
+      expect(testedComponent.prop('value')).toEqual('@country, @count ');
+
+      testedComponent.simulate('change', { target: { value: '@country, @count D' } });
+      expect(onChange).toHaveBeenCalledWith({
+        ...query,
+        searchPipeline: [{ type: SearchStepValue.SORTBY, fields: ['@country', ' @count D'] }],
+      });
+    });
+
+    it('Should keep separator after the last field', () => {
+      const query = getQuery({
+        type: QueryTypeValue.SEARCH,
+        command: RediSearch.AGGREGATE,
+        searchPipeline: [{ type: SearchStepValue.GROUPBY, fields: ['@country'] }],
+      });
+      const wrapper = getWrapper(query);
+      getField(wrapper, 'Fields').simulate('change', { target: { value: '@country,' } });
+      expect(onChange).toHaveBeenCalledWith({
+        ...query,
+        searchPipeline: [{ type: SearchStepValue.GROUPBY, fields: ['@country', ''] }],
+      });
+    });
+
+    it('Should update expression', () => {
+      const query = getQuery({
+        type: QueryTypeValue.SEARCH,
+        command: RediSearch.AGGREGATE,
+        searchPipeline: [{ type: SearchStepValue.APPLY }],
+      });
+      const wrapper = getWrapper(query);
+      getField(wrapper, 'Expression').simulate('change', { target: { value: '@count*2' } });
+      getField(wrapper, 'As').simulate('change', { target: { value: 'double' } });
+      expect(onChange).toHaveBeenCalledWith({
+        ...query,
+        searchPipeline: [{ type: SearchStepValue.APPLY, expression: '@count*2' }],
+      });
+      expect(onChange).toHaveBeenCalledWith({
+        ...query,
+        searchPipeline: [{ type: SearchStepValue.APPLY, as: 'double' }],
+      });
+    });
+
+    it('Should update limit', () => {
+      const query = getQuery({
+        type: QueryTypeValue.SEARCH,
+        command: RediSearch.AGGREGATE,
+        searchPipeline: [{ type: SearchStepValue.LIMIT }],
+      });
+      const wrapper = getWrapper(query);
+      getField(wrapper, 'Offset').simulate('change', { target: { value: '10' } });
+      getField(wrapper, 'Count').simulate('change', { target: { value: '5' } });
+      expect(onChange).toHaveBeenCalledWith({
+        ...query,
+        searchPipeline: [{ type: SearchStepValue.LIMIT, offset: 10 }],
+      });
+      expect(onChange).toHaveBeenCalledWith({
+        ...query,
+        searchPipeline: [{ type: SearchStepValue.LIMIT, count: 5 }],
+      });
+    });
+
+    it('Should add reducer', () => {
+      const query = getQuery({
+        type: QueryTypeValue.SEARCH,
+        command: RediSearch.AGGREGATE,
+        searchPipeline: [{ type: SearchStepValue.GROUPBY, fields: ['@country'] }],
+      });
+      const wrapper = getWrapper(query);
+      const testedComponent = wrapper.findWhere((node) => {
+        return node.prop('children') === 'Add Reducer';
+      });
+      testedComponent.simulate('click');
+      expect(onChange).toHaveBeenCalledWith({
+        ...query,
+        searchPipeline: [{ type: SearchStepValue.GROUPBY, fields: ['@country'], reducers: [{ function: 'COUNT' }] }],
+      });
+    });
+
+    it('Should keep separator after the last reducer argument', () => {
+      const query = getQuery({
+        type: QueryTypeValue.SEARCH,
+        command: RediSearch.AGGREGATE,
+        searchPipeline: [{ type: SearchStepValue.GROUPBY, reducers: [{ function: 'QUANTILE', args: ['@price'] }] }],
+      });
+      const wrapper = getWrapper(query);
+      const testedComponent = getField(wrapper, 'Args');
+      expect(testedComponent.prop('value')).toEqual('@price');
+
+      testedComponent.simulate('change', { target: { value: '@price,' } });
+      expect(onChange).toHaveBeenCalledWith({
+        ...query,
+        searchPipeline: [{ type: SearchStepValue.GROUPBY, reducers: [{ function: 'QUANTILE', args: ['@price', ''] }] }],
+      });
+    });
+  });
+
+  /**
+   * CONFIG GET
+   */
+  describe('Config fields', () => {
+    runQueryFieldsTest([
+      {
+        name: 'compareNodes',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onCompareNodesChange;
+          }),
+        type: 'switch',
+        queryWhenShown: { refId: '', type: QueryTypeValue.REDIS, command: Redis.CONFIG_GET },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.REDIS, command: Redis.INFO },
+      },
+      {
+        name: 'match',
+        testName: 'match for CONFIG GET',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.name() === 'FormField' && node.prop('label') === 'Match pattern';
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.REDIS, command: Redis.CONFIG_GET },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.REDIS, command: Redis.INFO },
+      },
+    ]);
+  });
+
+  /**
+   * Range options
+   */
+  describe('Range options', () => {
+    runQueryFieldsTest([
+      {
+        name: 'align',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onAlignChange;
+          }),
+        type: 'select',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+        },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.RANGE },
+      },
+      {
+        name: 'bucketTimestamp',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onBucketTimestampChange;
+          }),
+        type: 'select',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+        },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.RANGE },
+      },
+      {
+        name: 'empty',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onEmptyChange;
+          }),
+        type: 'switch',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+        },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.RANGE },
+      },
+      {
+        name: 'filterByTs',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onFilterByTsChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.RANGE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.GET },
+      },
+      {
+        name: 'filterByValueMin',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onFilterByValueMinChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.RANGE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.GET },
+      },
+      {
+        name: 'filterByValueMax',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onFilterByValueMaxChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.RANGE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.GET },
+      },
+      {
+        name: 'latest',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onLatestChange;
+          }),
+        type: 'switch',
+        queryWhenShown: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.RANGE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.GET },
+      },
+    ]);
+  });
+
+  /**
+   * Fill missing intervals
+   */
+  describe('Fill fields', () => {
+    runQueryFieldsTest([
+      {
+        name: 'fillMode',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onFillModeChange;
+          }),
+        type: 'select',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+          bucket: 123,
+          fill: true,
+        },
+        queryWhenHidden: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+          bucket: 123,
+          fill: false,
+        },
+      },
+      {
+        name: 'fillValue',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onFillValueChange;
+          }),
+        type: 'number',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+          bucket: 123,
+          fill: true,
+          fillMode: FillModeValue.VALUE,
+        },
+        queryWhenHidden: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+          bucket: 123,
+          fill: true,
+          fillMode: FillModeValue.ZERO,
+        },
+      },
+    ]);
+  });
+
+  /**
+   * Automatic time bucket
+   */
+  describe('Time bucket fields', () => {
+    runQueryFieldsTest([
+      {
+        name: 'autoBucket',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onAutoBucketChange;
+          }),
+        type: 'switch',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+        },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.RANGE },
+      },
+      {
+        name: 'bucket',
+        testName: 'bucket with automatic time bucket',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onBucketChange;
+          }),
+        type: 'number',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+        },
+        queryWhenHidden: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+          autoBucket: true,
+        },
+      },
+      {
+        name: 'minBucket',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onMinBucketChange;
+          }),
+        type: 'number',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+          autoBucket: true,
+        },
+        queryWhenHidden: {
+          refId: '',
+          type: QueryTypeValue.TIMESERIES,
+          command: RedisTimeSeries.RANGE,
+          aggregation: AggregationValue.AVG,
+          autoBucket: false,
+        },
+      },
+    ]);
+  });
+
+  /**
+   * Selected labels
+   */
+  describe('Label fields', () => {
+    runQueryFieldsTest([
+      {
+        name: 'selectedLabels',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSelectedLabelsChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.MRANGE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.RANGE },
+      },
+      {
+        name: 'selectedLabels',
+        testName: 'selectedLabels for TS.MGET',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSelectedLabelsChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.MGET },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.GET },
+      },
+      {
+        name: 'legend',
+        testName: 'Legend Label for TS.MREVRANGE',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.name() === 'FormField' && node.prop('label') === 'Legend Label';
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.MREVRANGE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.REVRANGE },
+      },
+    ]);
+  });
+
+  /**
+   * Multi range output format
+   */
+  describe('Format fields', () => {
+    runQueryFieldsTest([
+      {
+        name: 'tsFormat',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onTsFormatChange;
+          }),
+        type: 'radioButton',
+        queryWhenShown: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.MRANGE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.RANGE },
+      },
+    ]);
+  });
+
+  /**
+   * TS.INFO DEBUG
+   */
+  describe('Debug fields', () => {
+    runQueryFieldsTest([
+      {
+        name: 'debug',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onDebugChange;
+          }),
+        type: 'switch',
+        queryWhenShown: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.INFO },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.GET },
+      },
+    ]);
+  });
+
+  /**
+   * Time-series expressions
+   */
+  describe('Expression fields', () => {
+    runQueryFieldsTest([
+      {
+        name: 'expression',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onExpressionChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.RANGE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.GET },
+      },
+      {
+        name: 'expression',
+        testName: 'expression for TS.MRANGE',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onExpressionChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.MRANGE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.TIMESERIES, command: RedisTimeSeries.MGET },
+      },
+    ]);
+  });
+
+  /**
+   * Query parameters and dialect
+   */
+  describe('Search parameters', () => {
+    runQueryFieldsTest([
+      {
+        name: 'dialect',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onDialectChange;
+          }),
+        type: 'number',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.INFO },
+      },
+      {
+        name: 'dialect',
+        testName: 'dialect for FT.AGGREGATE',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onDialectChange;
+          }),
+        type: 'number',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.REDIS, command: Redis.INFO },
+      },
+    ]);
+
+    describe('searchParams', () => {
+      const getWrapper = (query: RedisQuery) =>
+        shallow<QueryEditor>(
+          <QueryEditor datasource={{} as any} query={query} onRunQuery={onRunQuery} onChange={onChange} />
+        );
+
+      it('Should not be shown', () => {
+        const query = getQuery({ type: QueryTypeValue.SEARCH, command: RediSearch.INFO });
+        const wrapper = getWrapper(query);
+        const testedComponent = wrapper.findWhere((node) => {
+          return node.prop('onClick') === wrapper.instance().onSearchParamAdd;
+        });
+        expect(testedComponent.exists()).not.toBeTruthy();
+      });
+
+      it('Should add parameter', () => {
+        const query = getQuery({ type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH });
+        const wrapper = getWrapper(query);
+        const testedComponent = wrapper.findWhere((node) => {
+          return node.prop('onClick') === wrapper.instance().onSearchParamAdd;
+        });
+        testedComponent.simulate('click');
+        expect(onChange).toHaveBeenCalledWith({
+          ...query,
+          searchParams: [{ name: '', type: SearchParamTypeValue.TEXT }],
+        });
+      });
+
+      it('Should update parameter', () => {
+        const query = getQuery({
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.AGGREGATE,
+          searchParams: [{ name: 'vec', type: SearchParamTypeValue.TEXT }],
+        });
+        const wrapper = getWrapper(query);
+        wrapper
+          .findWhere((node) => {
+            return node.name() === 'FormField' && node.prop('label') === 'Name';
+          })
+          .simulate('change', { target: { value: 'blob' } });
+        wrapper
+          .findWhere((node) => {
+            return node.prop('options') === SearchParamTypes;
+          })
+          .simulate('change', { value: SearchParamTypeValue.FLOAT32 });
+        wrapper
+          .findWhere((node) => {
+            return node.name() === 'FormField' && node.prop('label') === 'Value';
+          })
+          .simulate('change', { target: { value: '1,2' } });
+
+        expect(onChange).toHaveBeenCalledWith({
+          ...query,
+          searchParams: [{ name: 'blob', type: SearchParamTypeValue.TEXT }],
+        });
+        expect(onChange).toHaveBeenCalledWith({
+          ...query,
+          searchParams: [{ name: 'vec', type: SearchParamTypeValue.FLOAT32 }],
+        });
+        expect(onChange).toHaveBeenCalledWith({
+          ...query,
+          searchParams: [{ name: 'vec', type: SearchParamTypeValue.TEXT, value: '1,2' }],
+        });
+      });
+
+      it('Should remove parameter', () => {
+        const query = getQuery({
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.SEARCH,
+          searchParams: [{ name: 'vec' }, { name: 'k' }],
+        });
+        const wrapper = getWrapper(query);
+        const testedComponent = wrapper.findWhere((node) => {
+          return node.prop('icon') === 'trash-alt';
+        });
+        testedComponent.first().simulate('click');
+        expect(onChange).toHaveBeenCalledWith({ ...query, searchParams: [{ name: 'k' }] });
+      });
+    });
+  });
+
+  /**
+   * Query diagnostics
+   */
+  describe('Profile fields', () => {
+    runQueryFieldsTest([
+      {
+        name: 'searchProfile',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSearchProfileChange;
+          }),
+        type: 'radioButton',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.PROFILE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+      },
+      {
+        name: 'searchQuery',
+        testName: 'searchQuery for FT.EXPLAIN',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSearchQueryChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.EXPLAIN },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.INFO },
+      },
+    ]);
+
+    it('Should show pipeline for FT.AGGREGATE profile', () => {
+      const query = getQuery({
+        type: QueryTypeValue.SEARCH,
+        command: RediSearch.PROFILE,
+        searchProfile: SearchProfileValue.AGGREGATE,
+      });
+      const wrapper = shallow<QueryEditor>(
+        <QueryEditor datasource={{} as any} query={query} onRunQuery={onRunQuery} onChange={onChange} />
+      );
+      const testedComponent = wrapper.findWhere((node) => {
+        return node.prop('onClick') === wrapper.instance().onSearchStepAdd;
+      });
+      expect(testedComponent.exists()).toBeTruthy();
+    });
+  });
+
+  /**
+   * FT.TAGVALS, FT.SUGGET and FT.SPELLCHECK
+   */
+  describe('Suggestion fields', () => {
+    runQueryFieldsTest([
+      {
+        name: 'field',
+        testName: 'field for FT.TAGVALS',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.name() === 'FormField' && node.prop('label') === 'Field';
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.TAGVALS },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+      },
+      {
+        name: 'prefix',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onPrefixChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SUGGET },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+      },
+      {
+        name: 'fuzzy',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onFuzzyChange;
+          }),
+        type: 'switch',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SUGGET },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+      },
+      {
+        name: 'count',
+        testName: 'count for FT.SUGGET',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.name() === 'FormField' && node.prop('label') === 'Count';
+          }),
+        type: 'number',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SUGGET },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.TAGVALS },
+      },
+      {
+        name: 'distance',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onDistanceChange;
+          }),
+        type: 'number',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SPELLCHECK },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+      },
+      {
+        name: 'includeTerms',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onIncludeTermsChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SPELLCHECK },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+      },
+      {
+        name: 'excludeTerms',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onExcludeTermsChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SPELLCHECK },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+      },
+    ]);
+  });
+
+  /**
+   * FT.SEARCH options
+   */
+  describe('Search options', () => {
+    runQueryFieldsTest([
+      {
+        name: 'withScores',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onWithScoresChange;
+          }),
+        type: 'switch',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE },
+      },
+      {
+        name: 'noContent',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onNoContentChange;
+          }),
+        type: 'switch',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE },
+      },
+      {
+        name: 'verbatim',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onVerbatimChange;
+          }),
+        type: 'switch',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE },
+      },
+      {
+        name: 'highlight',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onHighlightChange;
+          }),
+        type: 'switch',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, noContent: true },
+      },
+      {
+        name: 'highlightOpen',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onHighlightOpenChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, highlight: true },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, highlight: false },
+      },
+      {
+        name: 'highlightClose',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onHighlightCloseChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, highlight: true },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, highlight: false },
+      },
+      {
+        name: 'summarize',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSummarizeChange;
+          }),
+        type: 'switch',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, noContent: true },
+      },
+      {
+        name: 'summarizeFrags',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSummarizeFragsChange;
+          }),
+        type: 'number',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, summarize: true },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, summarize: false },
+      },
+      {
+        name: 'summarizeLen',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSummarizeLenChange;
+          }),
+        type: 'number',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, summarize: true },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, summarize: false },
+      },
+      {
+        name: 'summarizeSeparator',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSummarizeSeparatorChange;
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, summarize: true },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, summarize: false },
+      },
+    ]);
+
+    /**
+     * Comma separated lists
+     */
+    const listFields: Array<{ name: keyof RedisQuery; handler: keyof QueryEditor; query: object }> = [
+      { name: 'inKeys', handler: 'onInKeysChange', query: {} },
+      { name: 'inFields', handler: 'onInFieldsChange', query: {} },
+      { name: 'highlightFields', handler: 'onHighlightFieldsChange', query: { highlight: true } },
+      { name: 'summarizeFields', handler: 'onSummarizeFieldsChange', query: { summarize: true } },
+    ];
+
+    listFields.forEach(({ name, handler, query: listQuery }) => {
+      describe(name, () => {
+        const getComponent = (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance()[handler];
+          });
+
+        it('Should not be shown', () => {
+          const query = getQuery({ type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE, ...listQuery });
+          const wrapper = shallow<QueryEditor>(
+            <QueryEditor datasource={{} as any} query={query} onRunQuery={onRunQuery} onChange={onChange} />
+          );
+          expect(getComponent(wrapper).exists()).not.toBeTruthy();
+        });
+
+        it('Should set value from query', () => {
+          const query = getQuery({
+            type: QueryTypeValue.SEARCH,
+            command: RediSearch.SEARCH,
+            [name]: ['title', ' body'],
+            ...listQuery,
+          });
+          const wrapper = shallow<QueryEditor>(
+            <QueryEditor datasource={{} as any} query={query} onRunQuery={onRunQuery} onChange={onChange} />
+          );
+          expect(getComponent(wrapper).prop('value')).toEqual('title, body');
+        });
+
+        it('Should keep value as typed', () => {
+          const query = getQuery({ type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH, ...listQuery });
+          const wrapper = shallow<QueryEditor>(
+            <QueryEditor datasource={{} as any} query={query} onRunQuery={onRunQuery} onChange={onChange} />
+          );
+          getComponent(wrapper).simulate('change', { target: { value: 'title, ' } });
+          expect(onChange).toHaveBeenCalledWith({ ...query, [name]: ['title', ' '] });
+
+          getComponent(wrapper).simulate('change', { target: { value: '' } });
+          expect(onChange).toHaveBeenCalledWith({ ...query, [name]: [] });
+        });
+      });
+    });
+  });
+
+  /**
+   * FT.SEARCH and FT.AGGREGATE cursor
+   */
+  describe('Search cursor', () => {
+    runQueryFieldsTest([
+      {
+        name: 'withCursor',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onWithCursorChange;
+          }),
+        type: 'switch',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+      },
+      {
+        name: 'count',
+        testName: 'Page Size',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.name() === 'FormField' && node.prop('label') === 'Page Size';
+          }),
+        type: 'number',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE, withCursor: true },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE },
+      },
+      {
+        name: 'maxIdle',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onMaxIdleChange;
+          }),
+        type: 'number',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE, withCursor: true },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE },
+      },
+      {
+        name: 'cursor',
+        testName: 'cursor for FT.AGGREGATE',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.name() === 'FormField' && node.prop('label') === 'Cursor';
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE, withCursor: true },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.AGGREGATE },
+      },
+      {
+        name: 'cursor',
+        testName: 'cursor for FT.SEARCH',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.name() === 'FormField' && node.prop('label') === 'Cursor';
+          }),
+        type: 'string',
+        queryWhenShown: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.INFO },
+      },
+    ]);
+  });
+
+  /**
+   * FT.SEARCH time range
+   */
+  describe('Search time filter', () => {
+    runQueryFieldsTest([
+      {
+        name: 'searchTimeFilter',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSearchTimeFilterChange;
+          }),
+        type: 'switch',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.SEARCH,
+          searchTimeField: 'created',
+        },
+        queryWhenHidden: {
+          refId: '',
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.AGGREGATE,
+          searchTimeField: 'created',
+        },
+      },
+      {
+        name: 'searchTimeFilter',
+        testName: 'searchTimeFilter without timestamp field',
+        getComponent: (wrapper: ShallowComponent) =>
+          wrapper.findWhere((node) => {
+            return node.prop('onChange') === wrapper.instance().onSearchTimeFilterChange;
+          }),
+        type: 'switch',
+        queryWhenShown: {
+          refId: '',
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.SEARCH,
+          searchTimeField: 'created',
+        },
+        queryWhenHidden: { refId: '', type: QueryTypeValue.SEARCH, command: RediSearch.SEARCH },
+      },
+    ]);
+  });
+
   /**
    * Streaming options
    */
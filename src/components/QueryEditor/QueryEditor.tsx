@@ -19,6 +19,12 @@ import { DataSource } from '../../datasource';
 import {
   Aggregations,
   AggregationValue,
+  Aligns,
+  AlignValue,
+  BucketTimestamps,
+  BucketTimestampValue,
+  FillModes,
+  FillModeValue,
   CommandParameters,
   Commands,
   InfoSections,
@@ -33,11 +39,28 @@ import {
   RedisTimeSeries,
   Reducers,
   ReducerValue,
+  TsFormats,
+  TsFormatValue,
   ZRangeQuery,
   ZRangeQueryValue,
 } from '../../redis';
 import { RedisDataSourceOptions } from '../../types';
-import { RediSearch, SortDirection, SortDirectionValue } from '../../redis/search';
+import {
+  RediSearch,
+  SearchParam,
+  SearchProfiles,
+  SearchProfileValue,
+  SearchParamTypes,
+  SearchParamTypeValue,
+  SearchReducer,
+  SearchStep,
+  SearchSteps,
+  SearchStepValue,
+  SearchTimeUnits,
+  SearchTimeUnitValue,
+  SortDirection,
+  SortDirectionValue,
+} from '../../redis/search';
 import { FieldValuesContainer } from '../../redis/fieldValuesContainer';
 
 /**
@@ -112,6 +135,15 @@ export class QueryEditor extends PureComponent<Props> {
     this.props.onChange({ ...this.props.query, [name]: event.currentTarget.checked });
   };
 
+  /**
+   * Change handler for comma separated list field
+   *
+   * @param {ChangeEvent<HTMLInputElement>} event Event
+   */
+  createListFieldHandler = (name: keyof RedisQuery) => (event: ChangeEvent<HTMLInputElement>) => {
+    this.props.onChange({ ...this.props.query, [name]: this.splitList(event.target.value) });
+  };
+
   createFieldArrayHandler = (name: 'returnFields') => (event: React.SyntheticEvent<HTMLInputElement>) => {
     const index = Number(event.currentTarget.name.split(':', 2)[1]);
     if (!this.props.query[name]) {
@@ -201,6 +233,26 @@ export class QueryEditor extends PureComponent<Props> {
    */
   onTsGroupByLabelChange = this.createTextFieldHandler('tsGroupByLabel');
 
+  /**
+   * Selected labels change
+   */
+  onSelectedLabelsChange = this.createTextFieldHandler('selectedLabels');
+
+  /**
+   * Output format change
+   */
+  onTsFormatChange = this.createRedioButtonFieldHandler<TsFormatValue>('tsFormat');
+
+  /**
+   * Debug change
+   */
+  onDebugChange = this.createSwitchFieldHandler('debug');
+
+  /**
+   * Expression change
+   */
+  onExpressionChange = this.createTextFieldHandler('expression');
+
   /**
    * Aggregation change
    */
@@ -226,6 +278,16 @@ export class QueryEditor extends PureComponent<Props> {
    */
   onBucketChange = this.createNumberFieldHandler('bucket');
 
+  /**
+   * Auto bucket change
+   */
+  onAutoBucketChange = this.createSwitchFieldHandler('autoBucket');
+
+  /**
+   * Minimum bucket change
+   */
+  onMinBucketChange = this.createNumberFieldHandler('minBucket');
+
   /**
    * Size change
    */
@@ -256,6 +318,11 @@ export class QueryEditor extends PureComponent<Props> {
    */
   onMatchChange = this.createTextFieldHandler('match');
 
+  /**
+   * Compare nodes change
+   */
+  onCompareNodesChange = this.createSwitchFieldHandler('compareNodes');
+
   /**
    * Start change
    */
@@ -281,6 +348,51 @@ export class QueryEditor extends PureComponent<Props> {
    */
   onFillChange = this.createSwitchFieldHandler('fill');
 
+  /**
+   * Fill mode change
+   */
+  onFillModeChange = this.createSelectFieldHandler<FillModeValue>('fillMode');
+
+  /**
+   * Fill value change
+   */
+  onFillValueChange = this.createNumberFieldHandler('fillValue');
+
+  /**
+   * Filter by timestamps change
+   */
+  onFilterByTsChange = this.createTextFieldHandler('filterByTs');
+
+  /**
+   * Filter by value minimum change
+   */
+  onFilterByValueMinChange = this.createTextFieldHandler('filterByValueMin');
+
+  /**
+   * Filter by value maximum change
+   */
+  onFilterByValueMaxChange = this.createTextFieldHandler('filterByValueMax');
+
+  /**
+   * Align change
+   */
+  onAlignChange = this.createSelectFieldHandler<AlignValue>('align');
+
+  /**
+   * Latest change
+   */
+  onLatestChange = this.createSwitchFieldHandler('latest');
+
+  /**
+   * Empty change
+   */
+  onEmptyChange = this.createSwitchFieldHandler('empty');
+
+  /**
+   * Bucket timestamp change
+   */
+  onBucketTimestampChange = this.createSelectFieldHandler<BucketTimestampValue>('bucketTimestamp');
+
   /**
    * Streaming change
    */
@@ -303,6 +415,171 @@ export class QueryEditor extends PureComponent<Props> {
 
   onReturnFieldChange = this.createFieldArrayHandler('returnFields');
 
+  /**
+   * FT.AGGREGATE timestamp field change
+   */
+  onSearchTimeFieldChange = this.createTextFieldHandler('searchTimeField');
+
+  /**
+   * FT.AGGREGATE timestamp unit change
+   */
+  onSearchTimeUnitChange = this.createRedioButtonFieldHandler<SearchTimeUnitValue>('searchTimeUnit');
+
+  /**
+   * FT.SEARCH time filter change
+   */
+  onSearchTimeFilterChange = this.createSwitchFieldHandler('searchTimeFilter');
+
+  /**
+   * Update FT.AGGREGATE pipeline step
+   *
+   * @param {number} index Step index
+   * @param {Partial<SearchStep>} step Changed properties
+   */
+  updateSearchStep = (index: number, step: Partial<SearchStep>) => {
+    const searchPipeline = [...(this.props.query.searchPipeline || [])];
+    searchPipeline[index] = { ...searchPipeline[index], ...step };
+
+    this.props.onChange({ ...this.props.query, searchPipeline });
+  };
+
+  /**
+   * Update FT.AGGREGATE GROUPBY reducer
+   *
+   * @param {number} index Step index
+   * @param {number} reducerIndex Reducer index
+   * @param {Partial<SearchReducer>} reducer Changed properties
+   */
+  updateSearchReducer = (index: number, reducerIndex: number, reducer: Partial<SearchReducer>) => {
+    const reducers = [...(this.props.query.searchPipeline?.[index].reducers || [])];
+    reducers[reducerIndex] = { ...reducers[reducerIndex], ...reducer };
+
+    this.updateSearchStep(index, { reducers });
+  };
+
+  /**
+   * Add FT.AGGREGATE pipeline step
+   */
+  onSearchStepAdd = () => {
+    const searchPipeline = [...(this.props.query.searchPipeline || []), { type: SearchStepValue.GROUPBY }];
+    this.props.onChange({ ...this.props.query, searchPipeline });
+  };
+
+  /**
+   * Remove FT.AGGREGATE pipeline step
+   *
+   * @param {number} index Step index
+   */
+  onSearchStepRemove = (index: number) => {
+    const searchPipeline = (this.props.query.searchPipeline || []).filter((step, i) => i !== index);
+    this.props.onChange({ ...this.props.query, searchPipeline });
+  };
+
+  /**
+   * FT.PROFILE command change
+   */
+  onSearchProfileChange = this.createRedioButtonFieldHandler<SearchProfileValue>('searchProfile');
+
+  /**
+   * FT.SUGGET prefix change
+   */
+  onPrefixChange = this.createTextFieldHandler('prefix');
+
+  /**
+   * FT.SUGGET fuzzy change
+   */
+  onFuzzyChange = this.createSwitchFieldHandler('fuzzy');
+
+  /**
+   * FT.SPELLCHECK distance change
+   */
+  onDistanceChange = this.createNumberFieldHandler('distance');
+
+  /**
+   * FT.SPELLCHECK include terms change
+   */
+  onIncludeTermsChange = this.createTextFieldHandler('includeTerms');
+
+  /**
+   * FT.SPELLCHECK exclude terms change
+   */
+  onExcludeTermsChange = this.createTextFieldHandler('excludeTerms');
+
+  /**
+   * FT.SEARCH options change
+   */
+  onWithScoresChange = this.createSwitchFieldHandler('withScores');
+  onNoContentChange = this.createSwitchFieldHandler('noContent');
+  onVerbatimChange = this.createSwitchFieldHandler('verbatim');
+  onInKeysChange = this.createListFieldHandler('inKeys');
+  onInFieldsChange = this.createListFieldHandler('inFields');
+
+  /**
+   * FT.SEARCH highlight change
+   */
+  onHighlightChange = this.createSwitchFieldHandler('highlight');
+  onHighlightFieldsChange = this.createListFieldHandler('highlightFields');
+  onHighlightOpenChange = this.createTextFieldHandler('highlightOpen');
+  onHighlightCloseChange = this.createTextFieldHandler('highlightClose');
+
+  /**
+   * FT.SEARCH summarize change
+   */
+  onSummarizeChange = this.createSwitchFieldHandler('summarize');
+  onSummarizeFieldsChange = this.createListFieldHandler('summarizeFields');
+  onSummarizeFragsChange = this.createNumberFieldHandler('summarizeFrags');
+  onSummarizeLenChange = this.createNumberFieldHandler('summarizeLen');
+  onSummarizeSeparatorChange = this.createTextFieldHandler('summarizeSeparator');
+
+  /**
+   * Search cursor change
+   */
+  onWithCursorChange = this.createSwitchFieldHandler('withCursor');
+  onMaxIdleChange = this.createNumberFieldHandler('maxIdle');
+
+  /**
+   * Dialect change
+   */
+  onDialectChange = this.createNumberFieldHandler('dialect');
+
+  /**
+   * Update query parameter
+   *
+   * @param {number} index Parameter index
+   * @param {Partial<SearchParam>} param Changed properties
+   */
+  updateSearchParam = (index: number, param: Partial<SearchParam>) => {
+    const searchParams = [...(this.props.query.searchParams || [])];
+    searchParams[index] = { ...searchParams[index], ...param };
+
+    this.props.onChange({ ...this.props.query, searchParams });
+  };
+
+  /**
+   * Add query parameter
+   */
+  onSearchParamAdd = () => {
+    const searchParams = [...(this.props.query.searchParams || []), { name: '', type: SearchParamTypeValue.TEXT }];
+    this.props.onChange({ ...this.props.query, searchParams });
+  };
+
+  /**
+   * Remove query parameter
+   *
+   * @param {number} index Parameter index
+   */
+  onSearchParamRemove = (index: number) => {
+    const searchParams = (this.props.query.searchParams || []).filter((param, i) => i !== index);
+    this.props.onChange({ ...this.props.query, searchParams });
+  };
+
+  /**
+   * Split comma separated list, values are trimmed by the backend to keep the input editable
+   *
+   * @param {string} value Value
+   */
+  splitList = (value: string): string[] => (value ? value.split(',') : []);
+
   /**
    * Render Editor
    */
@@ -316,6 +593,8 @@ export class QueryEditor extends PureComponent<Props> {
       aggregation,
       zrangeQuery,
       bucket,
+      autoBucket,
+      minBucket,
       legend,
       command,
       field,
@@ -325,6 +604,34 @@ export class QueryEditor extends PureComponent<Props> {
       value,
       query,
       searchQuery,
+      searchPipeline,
+      searchTimeField,
+      searchTimeUnit,
+      searchTimeFilter,
+      searchParams,
+      dialect,
+      searchProfile,
+      prefix,
+      fuzzy,
+      distance,
+      includeTerms,
+      excludeTerms,
+      withScores,
+      noContent,
+      verbatim,
+      inKeys,
+      inFields,
+      highlight,
+      highlightFields,
+      highlightOpen,
+      highlightClose,
+      summarize,
+      summarizeFields,
+      summarizeFrags,
+      summarizeLen,
+      summarizeSeparator,
+      withCursor,
+      maxIdle,
       offset,
       sortDirection,
       sortBy,
@@ -332,9 +639,19 @@ export class QueryEditor extends PureComponent<Props> {
       section,
       size,
       fill,
+      fillMode,
+      fillValue,
+      filterByTs,
+      filterByValueMin,
+      filterByValueMax,
+      align,
+      latest,
+      empty,
+      bucketTimestamp,
       cursor,
       count,
       match,
+      compareNodes,
       samples,
       start,
       end,
@@ -346,9 +663,23 @@ export class QueryEditor extends PureComponent<Props> {
       streamingDataType,
       tsGroupByLabel,
       tsReducer,
+      selectedLabels,
+      tsFormat,
+      debug,
+      expression,
     } = this.props.query;
     const { onRunQuery, datasource } = this.props;
 
+    /**
+     * FT.PROFILE uses parameters of the profiled command
+     */
+    const searchCommand =
+      command === RediSearch.PROFILE
+        ? searchProfile === SearchProfileValue.AGGREGATE
+          ? RediSearch.AGGREGATE
+          : RediSearch.SEARCH
+        : command;
+
     /**
      * Check if CLI disabled
      */
@@ -446,6 +777,18 @@ export class QueryEditor extends PureComponent<Props> {
                 value={legend}
                 onChange={this.onLegendChange}
                 label="Legend Label"
+                tooltip="Label or template with labels, i.e. {{region}}-{{host}}"
+              />
+            )}
+
+            {CommandParameters.selectedLabels.includes(command as RedisTimeSeries) && (
+              <FormField
+                labelWidth={8}
+                inputWidth={10}
+                value={selectedLabels}
+                onChange={this.onSelectedLabelsChange}
+                label="Labels"
+                tooltip="Return only selected labels to reduce payload, all labels if empty"
               />
             )}
 
@@ -484,6 +827,16 @@ export class QueryEditor extends PureComponent<Props> {
                 label="Match pattern"
               />
             )}
+
+            {CommandParameters.compareNodes.includes(command as Redis) && (
+              <Switch
+                label="Compare Nodes"
+                labelClass="width-10"
+                tooltip="If checked, the command will run on all Cluster or Sentinel nodes and highlight differences."
+                checked={compareNodes || false}
+                onChange={this.onCompareNodesChange}
+              />
+            )}
           </div>
         )}
 
@@ -524,7 +877,18 @@ export class QueryEditor extends PureComponent<Props> {
           </div>
         )}
 
-        {command && CommandParameters.searchQuery.includes(command as RediSearch) && (
+        {command && CommandParameters.searchProfile.includes(command as RediSearch) && (
+          <div className="gf-form">
+            <InlineFormLabel width={10}>Profile</InlineFormLabel>
+            <RadioButtonGroup
+              options={SearchProfiles}
+              value={searchProfile || SearchProfileValue.SEARCH}
+              onChange={this.onSearchProfileChange}
+            />
+          </div>
+        )}
+
+        {searchCommand && CommandParameters.searchQuery.includes(searchCommand as RediSearch) && (
           <div className="gf-form">
             <InlineFormLabel tooltip="The RediSearch Query to issue to the index." width={10}>
               Query
@@ -533,7 +897,521 @@ export class QueryEditor extends PureComponent<Props> {
           </div>
         )}
 
-        {command && CommandParameters.returnFields.includes(command as RediSearch) && (
+        {searchCommand && CommandParameters.searchParams.includes(searchCommand as RediSearch) && (
+          <>
+            {(searchParams || []).map((param, index) => (
+              <div className="gf-form" key={index}>
+                <InlineFormLabel width={10}>Parameter</InlineFormLabel>
+                <FormField
+                  labelWidth={6}
+                  inputWidth={10}
+                  value={param.name}
+                  onChange={(event: ChangeEvent<HTMLInputElement>) =>
+                    this.updateSearchParam(index, { name: event.target.value })
+                  }
+                  label="Name"
+                  tooltip="Parameter name used in the query as $name"
+                />
+                <Select
+                  className={css`
+                    margin-right: 5px;
+                  `}
+                  options={SearchParamTypes}
+                  width={20}
+                  onChange={(val) => this.updateSearchParam(index, { type: val.value })}
+                  value={param.type || SearchParamTypeValue.TEXT}
+                />
+                <FormField
+                  labelWidth={6}
+                  inputWidth={30}
+                  value={param.value}
+                  onChange={(event: ChangeEvent<HTMLInputElement>) =>
+                    this.updateSearchParam(index, { value: event.target.value })
+                  }
+                  label="Value"
+                />
+                <Button variant="secondary" icon="trash-alt" onClick={() => this.onSearchParamRemove(index)} />
+              </div>
+            ))}
+
+            <div className="gf-form">
+              <Button
+                variant="secondary"
+                icon="plus"
+                onClick={this.onSearchParamAdd}
+                className={css`
+                  margin-right: 5px;
+                `}
+              >
+                Add Parameter
+              </Button>
+            </div>
+          </>
+        )}
+
+        {command && CommandParameters.prefix.includes(command as RediSearch) && (
+          <div className="gf-form">
+            <FormField
+              labelWidth={8}
+              inputWidth={20}
+              value={prefix}
+              onChange={this.onPrefixChange}
+              label="Prefix"
+              tooltip="Prefix to complete"
+            />
+            <Switch
+              label="Fuzzy"
+              labelClass="width-5"
+              tooltip="If checked, prefixes with Levenshtein distance of 1 will be included."
+              checked={fuzzy || false}
+              onChange={this.onFuzzyChange}
+            />
+          </div>
+        )}
+
+        {command && CommandParameters.spellcheck.includes(command as RediSearch) && (
+          <div className="gf-form">
+            <FormField
+              labelWidth={8}
+              inputWidth={5}
+              value={distance}
+              type="number"
+              onChange={this.onDistanceChange}
+              label="Distance"
+              tooltip="Maximum Levenshtein distance for spelling suggestions, default is 1"
+            />
+            <FormField
+              labelWidth={8}
+              inputWidth={10}
+              value={includeTerms}
+              onChange={this.onIncludeTermsChange}
+              label="Include"
+              tooltip="Dictionary with custom terms to include"
+            />
+            <FormField
+              labelWidth={8}
+              inputWidth={10}
+              value={excludeTerms}
+              onChange={this.onExcludeTermsChange}
+              label="Exclude"
+              tooltip="Dictionary with terms to exclude"
+            />
+          </div>
+        )}
+
+        {searchCommand && CommandParameters.dialect.includes(searchCommand as RediSearch) && (
+          <FormField
+            labelWidth={10}
+            inputWidth={5}
+            value={dialect}
+            type="number"
+            onChange={this.onDialectChange}
+            label="Dialect"
+            tooltip="Query dialect. Dialect 2 is used for query parameters, if not specified."
+          />
+        )}
+
+        {searchCommand && CommandParameters.searchTimeField.includes(searchCommand as RediSearch) && (
+          <div className="gf-form">
+            <FormField
+              labelWidth={10}
+              inputWidth={10}
+              value={searchTimeField}
+              onChange={this.onSearchTimeFieldChange}
+              label="Timestamp Field"
+              tooltip="Numeric timestamp field. Aggregation groups documents in the time range into time buckets and returns time-series."
+            />
+            {searchTimeField && (
+              <RadioButtonGroup
+                options={SearchTimeUnits}
+                value={searchTimeUnit || SearchTimeUnitValue.MILLISECONDS}
+                onChange={this.onSearchTimeUnitChange}
+              />
+            )}
+            {searchTimeField && CommandParameters.searchTimeFilter.includes(searchCommand as RediSearch) && (
+              <Switch
+                label="Time Filter"
+                labelClass="width-8"
+                tooltip="If checked, only documents with the timestamp field in the dashboard time range are returned."
+                checked={searchTimeFilter || false}
+                onChange={this.onSearchTimeFilterChange}
+              />
+            )}
+            {searchTimeField && CommandParameters.searchPipeline.includes(searchCommand as RediSearch) && (
+              <>
+                <Switch
+                  label="Auto"
+                  labelClass="width-5"
+                  tooltip="If checked, time bucket will be calculated from the interval and maximum data points."
+                  checked={autoBucket || false}
+                  onChange={this.onAutoBucketChange}
+                />
+                {!autoBucket && (
+                  <FormField
+                    labelWidth={8}
+                    value={bucket}
+                    type="number"
+                    onChange={this.onBucketChange}
+                    label="Time Bucket"
+                    tooltip="Time bucket in milliseconds"
+                  />
+                )}
+                {autoBucket && (
+                  <FormField
+                    labelWidth={8}
+                    value={minBucket}
+                    type="number"
+                    onChange={this.onMinBucketChange}
+                    label="Min Bucket"
+                    tooltip="Minimum time bucket in milliseconds"
+                  />
+                )}
+              </>
+            )}
+          </div>
+        )}
+
+        {searchCommand && CommandParameters.searchPipeline.includes(searchCommand as RediSearch) && (
+          <>
+            {(searchPipeline || []).map((step, index) => (
+              <div key={index}>
+                <div className="gf-form">
+                  <InlineFormLabel width={10}>Step {index + 1}</InlineFormLabel>
+                  <Select
+                    className={css`
+                      margin-right: 5px;
+                    `}
+                    options={SearchSteps}
+                    width={20}
+                    onChange={(val) => this.updateSearchStep(index, { type: val.value })}
+                    value={step.type}
+                  />
+                  {[SearchStepValue.LOAD, SearchStepValue.GROUPBY, SearchStepValue.SORTBY].includes(step.type) && (
+                    <FormField
+                      labelWidth={6}
+                      inputWidth={20}
+                      value={(step.fields || []).join(',')}
+                      onChange={(event: ChangeEvent<HTMLInputElement>) =>
+                        this.updateSearchStep(index, { fields: this.splitList(event.target.value) })
+                      }
+                      label="Fields"
+                      tooltip="Comma separated list of properties, i.e. @country, @count DESC"
+                    />
+                  )}
+                  {[SearchStepValue.APPLY, SearchStepValue.FILTER].includes(step.type) && (
+                    <FormField
+                      labelWidth={8}
+                      inputWidth={20}
+                      value={step.expression}
+                      onChange={(event: ChangeEvent<HTMLInputElement>) =>
+                        this.updateSearchStep(index, { expression: event.target.value })
+                      }
+                      label="Expression"
+                    />
+                  )}
+                  {step.type === SearchStepValue.APPLY && (
+                    <FormField
+                      labelWidth={4}
+                      inputWidth={10}
+                      value={step.as}
+                      onChange={(event: ChangeEvent<HTMLInputElement>) =>
+                        this.updateSearchStep(index, { as: event.target.value })
+                      }
+                      label="As"
+                    />
+                  )}
+                  {step.type === SearchStepValue.SORTBY && (
+                    <FormField
+                      labelWidth={4}
+                      inputWidth={10}
+                      value={step.max}
+                      type="number"
+                      onChange={(event: ChangeEvent<HTMLInputElement>) =>
+                        this.updateSearchStep(index, { max: Number(event.target.value) })
+                      }
+                      label="Max"
+                    />
+                  )}
+                  {step.type === SearchStepValue.LIMIT && (
+                    <>
+                      <FormField
+                        labelWidth={6}
+                        inputWidth={10}
+                        value={step.offset}
+                        type="number"
+                        onChange={(event: ChangeEvent<HTMLInputElement>) =>
+                          this.updateSearchStep(index, { offset: Number(event.target.value) })
+                        }
+                        label="Offset"
+                      />
+                      <FormField
+                        labelWidth={6}
+                        inputWidth={10}
+                        value={step.count}
+                        type="number"
+                        onChange={(event: ChangeEvent<HTMLInputElement>) =>
+                          this.updateSearchStep(index, { count: Number(event.target.value) })
+                        }
+                        label="Count"
+                      />
+                    </>
+                  )}
+                  <Button variant="secondary" icon="trash-alt" onClick={() => this.onSearchStepRemove(index)} />
+                </div>
+
+                {step.type === SearchStepValue.GROUPBY && (
+                  <>
+                    {(step.reducers || []).map((reducer, reducerIndex) => (
+                      <div className="gf-form" key={reducerIndex}>
+                        <InlineFormLabel width={10}>Reduce</InlineFormLabel>
+                        <FormField
+                          labelWidth={6}
+                          inputWidth={10}
+                          value={reducer.function}
+                          onChange={(event: ChangeEvent<HTMLInputElement>) =>
+                            this.updateSearchReducer(index, reducerIndex, { function: event.target.value })
+                          }
+                          label="Function"
+                          tooltip="Reducer function, i.e. COUNT, SUM, AVG, MIN, MAX, COUNT_DISTINCT, QUANTILE"
+                        />
+                        <FormField
+                          labelWidth={6}
+                          inputWidth={15}
+                          value={(reducer.args || []).join(',')}
+                          onChange={(event: ChangeEvent<HTMLInputElement>) =>
+                            this.updateSearchReducer(index, reducerIndex, { args: this.splitList(event.target.value) })
+                          }
+                          label="Args"
+                          tooltip="Comma separated list of arguments, i.e. @price, 0.5"
+                        />
+                        <FormField
+                          labelWidth={4}
+                          inputWidth={10}
+                          value={reducer.as}
+                          onChange={(event: ChangeEvent<HTMLInputElement>) =>
+                            this.updateSearchReducer(index, reducerIndex, { as: event.target.value })
+                          }
+                          label="As"
+                        />
+                        <Button
+                          variant="secondary"
+                          icon="trash-alt"
+                          onClick={() =>
+                            this.updateSearchStep(index, {
+                              reducers: (step.reducers || []).filter((r, i) => i !== reducerIndex),
+                            })
+                          }
+                        />
+                      </div>
+                    ))}
+                    <div className="gf-form">
+                      <Button
+                        variant="secondary"
+                        icon="plus"
+                        onClick={() =>
+                          this.updateSearchStep(index, { reducers: [...(step.reducers || []), { function: 'COUNT' }] })
+                        }
+                      >
+                        Add Reducer
+                      </Button>
+                    </div>
+                  </>
+                )}
+              </div>
+            ))}
+
+            <div className="gf-form">
+              <Button variant="secondary" icon="plus" onClick={this.onSearchStepAdd}>
+                Add Step
+              </Button>
+            </div>
+          </>
+        )}
+
+        {searchCommand && CommandParameters.searchOptions.includes(searchCommand as RediSearch) && (
+          <>
+            <div className="gf-form">
+              <Switch
+                label="Scores"
+                labelClass="width-10"
+                tooltip="If checked, relative score of each document will be returned."
+                checked={withScores || false}
+                onChange={this.onWithScoresChange}
+              />
+              <Switch
+                label="No Content"
+                labelClass="width-8"
+                tooltip="If checked, only the key names will be returned."
+                checked={noContent || false}
+                onChange={this.onNoContentChange}
+              />
+              <Switch
+                label="Verbatim"
+                labelClass="width-8"
+                tooltip="If checked, query terms will not be expanded using stemming."
+                checked={verbatim || false}
+                onChange={this.onVerbatimChange}
+              />
+            </div>
+            <div className="gf-form">
+              <FormField
+                labelWidth={10}
+                inputWidth={20}
+                value={(inKeys || []).join(',')}
+                onChange={this.onInKeysChange}
+                label="In Keys"
+                tooltip="Comma separated list of keys to limit the search"
+              />
+              <FormField
+                labelWidth={8}
+                inputWidth={20}
+                value={(inFields || []).join(',')}
+                onChange={this.onInFieldsChange}
+                label="In Fields"
+                tooltip="Comma separated list of fields to search in"
+              />
+            </div>
+            {!noContent && (
+              <div className="gf-form">
+                <Switch
+                  label="Highlight"
+                  labelClass="width-10"
+                  tooltip="If checked, matching terms will be highlighted."
+                  checked={highlight || false}
+                  onChange={this.onHighlightChange}
+                />
+                {highlight && (
+                  <>
+                    <FormField
+                      labelWidth={6}
+                      inputWidth={15}
+                      value={(highlightFields || []).join(',')}
+                      onChange={this.onHighlightFieldsChange}
+                      label="Fields"
+                      tooltip="Comma separated list of fields to highlight, all fields if not specified"
+                    />
+                    <FormField
+                      labelWidth={6}
+                      inputWidth={6}
+                      value={highlightOpen}
+                      onChange={this.onHighlightOpenChange}
+                      placeholder="<b>"
+                      label="Open"
+                    />
+                    <FormField
+                      labelWidth={6}
+                      inputWidth={6}
+                      value={highlightClose}
+                      onChange={this.onHighlightCloseChange}
+                      placeholder="</b>"
+                      label="Close"
+                    />
+                  </>
+                )}
+              </div>
+            )}
+            {!noContent && (
+              <div className="gf-form">
+                <Switch
+                  label="Summarize"
+                  labelClass="width-10"
+                  tooltip="If checked, only fragments of the fields around the matching terms will be returned."
+                  checked={summarize || false}
+                  onChange={this.onSummarizeChange}
+                />
+                {summarize && (
+                  <>
+                    <FormField
+                      labelWidth={6}
+                      inputWidth={15}
+                      value={(summarizeFields || []).join(',')}
+                      onChange={this.onSummarizeFieldsChange}
+                      label="Fields"
+                      tooltip="Comma separated list of fields to summarize, all fields if not specified"
+                    />
+                    <FormField
+                      labelWidth={6}
+                      inputWidth={5}
+                      value={summarizeFrags}
+                      type="number"
+                      onChange={this.onSummarizeFragsChange}
+                      label="Frags"
+                      tooltip="Number of fragments"
+                    />
+                    <FormField
+                      labelWidth={6}
+                      inputWidth={5}
+                      value={summarizeLen}
+                      type="number"
+                      onChange={this.onSummarizeLenChange}
+                      label="Length"
+                      tooltip="Number of words in the fragment"
+                    />
+                    <FormField
+                      labelWidth={8}
+                      inputWidth={5}
+                      value={summarizeSeparator}
+                      onChange={this.onSummarizeSeparatorChange}
+                      placeholder="..."
+                      label="Separator"
+                    />
+                  </>
+                )}
+              </div>
+            )}
+          </>
+        )}
+
+        {command && CommandParameters.searchCursor.includes(command as RediSearch) && (
+          <div className="gf-form">
+            {command === RediSearch.AGGREGATE && (
+              <>
+                <Switch
+                  label="With Cursor"
+                  labelClass="width-10"
+                  tooltip="If checked, results will be read in pages using cursor returned in the Cursor frame."
+                  checked={withCursor || false}
+                  onChange={this.onWithCursorChange}
+                />
+                {withCursor && (
+                  <>
+                    <FormField
+                      labelWidth={8}
+                      inputWidth={6}
+                      value={count}
+                      type="number"
+                      onChange={this.onCountChange}
+                      label="Page Size"
+                      tooltip="Number of results per page"
+                    />
+                    <FormField
+                      labelWidth={8}
+                      inputWidth={6}
+                      value={maxIdle}
+                      type="number"
+                      onChange={this.onMaxIdleChange}
+                      placeholder="60000"
+                      label="Max Idle"
+                      tooltip="Idle time in milliseconds before the cursor is deleted"
+                    />
+                  </>
+                )}
+              </>
+            )}
+            {(command === RediSearch.SEARCH || withCursor) && (
+              <FormField
+                labelWidth={8}
+                inputWidth={10}
+                value={cursor}
+                onChange={this.onCursorChange}
+                label="Cursor"
+                tooltip="Cursor returned by the previous query to read the next page"
+              />
+            )}
+          </div>
+        )}
+
+        {searchCommand && CommandParameters.returnFields.includes(searchCommand as RediSearch) && (
           <Form id="returnFieldsForm" onSubmit={() => true} defaultValues={defaultValues}>
             {({ control }) => (
               <div className="gf-form">
@@ -562,7 +1440,7 @@ export class QueryEditor extends PureComponent<Props> {
           </Form>
         )}
 
-        {command && CommandParameters.offset.includes(command as RediSearch) && (
+        {searchCommand && CommandParameters.offset.includes(searchCommand as RediSearch) && (
           <FormField
             labelWidth={8}
             inputWidth={10}
@@ -574,7 +1452,7 @@ export class QueryEditor extends PureComponent<Props> {
           />
         )}
 
-        {command && CommandParameters.limit.includes(command as RediSearch) && (
+        {searchCommand && CommandParameters.limit.includes(searchCommand as RediSearch) && (
           <FormField
             labelWidth={8}
             inputWidth={10}
@@ -586,7 +1464,7 @@ export class QueryEditor extends PureComponent<Props> {
           />
         )}
 
-        {command && CommandParameters.sortBy.includes(command as RediSearch) && (
+        {searchCommand && CommandParameters.sortBy.includes(searchCommand as RediSearch) && (
           <div className="gf-form">
             <InlineFormLabel width={8}>Sort Direction</InlineFormLabel>
             <Select
@@ -710,6 +1588,15 @@ export class QueryEditor extends PureComponent<Props> {
                 menuPlacement="bottom"
               />
               {aggregation && (
+                <Switch
+                  label="Auto"
+                  labelClass="width-5"
+                  tooltip="If checked, time bucket will be calculated from the interval and maximum data points."
+                  checked={autoBucket || false}
+                  onChange={this.onAutoBucketChange}
+                />
+              )}
+              {aggregation && !autoBucket && (
                 <FormField
                   labelWidth={8}
                   value={bucket}
@@ -719,15 +1606,125 @@ export class QueryEditor extends PureComponent<Props> {
                   tooltip="Time bucket for aggregation in milliseconds"
                 />
               )}
-              {aggregation && bucket && CommandParameters.fill.includes(command as RedisTimeSeries) && (
+              {aggregation && autoBucket && (
+                <FormField
+                  labelWidth={8}
+                  value={minBucket}
+                  type="number"
+                  onChange={this.onMinBucketChange}
+                  label="Min Bucket"
+                  tooltip="Minimum time bucket for aggregation in milliseconds"
+                />
+              )}
+              {aggregation && (bucket || autoBucket) && CommandParameters.fill.includes(command as RedisTimeSeries) && (
                 <Switch
                   label="Fill Missing"
                   labelClass="width-10"
-                  tooltip="If checked, the datasource will fill missing intervals."
+                  tooltip="If checked, the datasource will fill missing intervals across the time range."
                   checked={fill || false}
                   onChange={this.onFillChange}
                 />
               )}
+              {aggregation &&
+                (bucket || autoBucket) &&
+                fill &&
+                CommandParameters.fill.includes(command as RedisTimeSeries) && (
+                  <Select
+                    className={css`
+                      margin-right: 5px;
+                    `}
+                    options={FillModes}
+                    width={20}
+                    onChange={this.onFillModeChange}
+                    value={fillMode || FillModeValue.ZERO}
+                    menuPlacement="bottom"
+                  />
+                )}
+              {aggregation && (bucket || autoBucket) && fill && fillMode === FillModeValue.VALUE && (
+                <FormField
+                  labelWidth={8}
+                  inputWidth={6}
+                  value={fillValue}
+                  type="number"
+                  onChange={this.onFillValueChange}
+                  label="Fill Value"
+                />
+              )}
+            </div>
+          )}
+
+        {type === QueryTypeValue.TIMESERIES &&
+          command &&
+          aggregation &&
+          CommandParameters.tsRangeOptions.includes(command as RedisTimeSeries) && (
+            <div className="gf-form">
+              <InlineFormLabel width={8}>Align</InlineFormLabel>
+              <Select
+                className={css`
+                  margin-right: 5px;
+                `}
+                options={Aligns}
+                width={20}
+                onChange={this.onAlignChange}
+                value={align}
+                menuPlacement="bottom"
+              />
+              <InlineFormLabel width={10}>Bucket Timestamp</InlineFormLabel>
+              <Select
+                className={css`
+                  margin-right: 5px;
+                `}
+                options={BucketTimestamps}
+                width={20}
+                onChange={this.onBucketTimestampChange}
+                value={bucketTimestamp}
+                menuPlacement="bottom"
+              />
+              <Switch
+                label="Empty"
+                labelClass="width-8"
+                tooltip="If checked, empty buckets will be reported. Useful for gauges instead of filling with zeros."
+                checked={empty || false}
+                onChange={this.onEmptyChange}
+              />
+            </div>
+          )}
+
+        {type === QueryTypeValue.TIMESERIES &&
+          command &&
+          CommandParameters.tsRangeOptions.includes(command as RedisTimeSeries) && (
+            <div className="gf-form">
+              <FormField
+                labelWidth={8}
+                inputWidth={20}
+                value={filterByTs}
+                onChange={this.onFilterByTsChange}
+                label="Timestamps"
+                tooltip="Filter samples by a list of specific timestamps in milliseconds"
+              />
+              <FormField
+                labelWidth={8}
+                inputWidth={6}
+                value={filterByValueMin}
+                onChange={this.onFilterByValueMinChange}
+                label="Min Value"
+                tooltip="Filter samples by minimum and maximum values"
+              />
+              <FormField
+                labelWidth={8}
+                inputWidth={6}
+                value={filterByValueMax}
+                onChange={this.onFilterByValueMaxChange}
+                label="Max Value"
+                tooltip="Filter samples by minimum and maximum values"
+              />
+              <Switch
+                label="Latest"
+                labelClass="width-8"
+                tooltip="If checked, the latest possibly partial bucket of the compacted time-series will be reported."
+                checked={latest || false}
+                onChange={this.onLatestChange}
+              />
             </div>
           )}
 
@@ -755,6 +1752,51 @@ export class QueryEditor extends PureComponent<Props> {
             </div>
           )}
 
+        {type === QueryTypeValue.TIMESERIES &&
+          command &&
+          CommandParameters.debug.includes(command as RedisTimeSeries) && (
+            <div className="gf-form">
+              <Switch
+                label="Debug"
+                labelClass="width-8"
+                tooltip="If checked, chunks and compaction rules will be returned as tables."
+                checked={debug || false}
+                onChange={this.onDebugChange}
+              />
+            </div>
+          )}
+
+        {type === QueryTypeValue.TIMESERIES &&
+          command &&
+          CommandParameters.expression.includes(command as RedisTimeSeries) && (
+            <div className="gf-form">
+              <FormField
+                labelWidth={8}
+                inputWidth={30}
+                value={expression}
+                onChange={this.onExpressionChange}
+                label="Expression"
+                placeholder="rate"
+                tooltip="Expression over time-series: rate, delta, moving_avg(n), ratio(a, b) or percentile(p). Ratio and percentile are calculated across time-series returned by the multi range query."
+              />
+            </div>
+          )}
+
+        {type === QueryTypeValue.TIMESERIES &&
+          command &&
+          CommandParameters.tsFormat.includes(command as RedisTimeSeries) && (
+            <div className="gf-form">
+              <InlineFormLabel width={8} tooltip="Frame for each time-series or a single wide or long frame">
+                Format
+              </InlineFormLabel>
+              <RadioButtonGroup
+                options={TsFormats}
+                value={tsFormat || TsFormatValue.MULTI}
+                onChange={this.onTsFormatChange}
+              />
+            </div>
+          )}
+
         <div className="gf-form">
           <Switch
             label="Streaming"
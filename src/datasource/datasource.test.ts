@@ -12,6 +12,7 @@ import {
 import { DataSourceWithBackend, setTemplateSrv, TemplateSrv } from '@grafana/runtime';
 import { ClientTypeValue, StreamingDataType } from '../constants';
 import { QueryTypeValue, RedisQuery } from '../redis';
+import { RediSearch } from '../redis/search';
 import { getQuery } from '../tests/utils';
 import { RedisDataSourceOptions } from '../types';
 import { DataSource } from './datasource';
@@ -297,6 +298,30 @@ describe('DataSource', () => {
           done();
         });
     });
+
+    it('Should use FT.TAGVALS command for tag values', (done) => {
+      const querySpyMethod = jest.spyOn(dataSource, 'query');
+
+      dataSource.metricFindQuery &&
+        dataSource
+          .metricFindQuery('FT.TAGVALS idx @country', { variable: { datasource: '123' } })
+          .then((result: MetricFindValue[]) => {
+            expect(querySpyMethod).toHaveBeenCalledWith({
+              targets: [
+                {
+                  refId: 'A',
+                  datasource: '123',
+                  type: QueryTypeValue.SEARCH,
+                  command: RediSearch.TAGVALS,
+                  keyName: 'idx',
+                  field: '@country',
+                },
+              ],
+            });
+            expect(result.length).toEqual(3);
+            done();
+          });
+    });
   });
 
   it('Should call query method with numbers', (done) => {
@@ -11,7 +11,8 @@ import {
 } from '@grafana/data';
 import { DataSourceWithBackend, getTemplateSrv } from '@grafana/runtime';
 import { DefaultStreamingInterval, StreamingDataType } from '../constants';
-import { RedisQuery } from '../redis';
+import { QueryTypeValue, RedisQuery } from '../redis';
+import { RediSearch } from '../redis/search';
 import { TimeSeriesStreaming } from '../time-series';
 import { RedisDataSourceOptions } from '../types';
 
@@ -43,12 +44,27 @@ export class DataSource extends DataSourceWithBackend<RedisQuery, RedisDataSourc
       return Promise.resolve([]);
     }
 
+    /**
+     * Tag values of the RediSearch index, i.e. FT.TAGVALS idx @country
+     */
+    const tagVals = query.match(/^\s*ft\.tagvals\s+(\S+)\s+(\S+)\s*$/i);
+    const target = tagVals
+      ? {
+          refId: 'A',
+          datasource: options.variable.datasource,
+          type: QueryTypeValue.SEARCH,
+          command: RediSearch.TAGVALS,
+          keyName: tagVals[1],
+          field: tagVals[2],
+        }
+      : { refId: 'A', datasource: options.variable.datasource, query };
+
     /**
      * Run Query
      */
     return lastValueFrom(
       this.query({
-        targets: [{ refId: 'A', datasource: options.variable.datasource, query }],
+        targets: [target],
       } as DataQueryRequest<RedisQuery>).pipe(
         switchMap$((response) => response.data),
         switchMap$((data: DataFrame) => data.fields),
@@ -22,9 +22,15 @@ export const Commands = {
  * Input for Commands
  */
 export const CommandParameters = {
-  aggregation: [RedisTimeSeries.RANGE, RedisTimeSeries.MRANGE],
-  field: [Redis.HGET, Redis.HMGET],
-  filter: [RedisTimeSeries.MRANGE, RedisTimeSeries.QUERYINDEX, RedisTimeSeries.MGET],
+  aggregation: [RedisTimeSeries.RANGE, RedisTimeSeries.REVRANGE, RedisTimeSeries.MRANGE, RedisTimeSeries.MREVRANGE],
+  field: [Redis.HGET, Redis.HMGET, RediSearch.TAGVALS],
+  filter: [
+    RedisTimeSeries.MRANGE,
+    RedisTimeSeries.MREVRANGE,
+    RedisTimeSeries.QUERYINDEX,
+    RedisTimeSeries.MGET,
+    RedisTimeSeries.CARDINALITY,
+  ],
   keyName: [
     Redis.GET,
     Redis.HGET,
@@ -34,8 +40,12 @@ export const CommandParameters = {
     Redis.HMGET,
     Redis.LLEN,
     Redis.SCARD,
+    Redis.SENTINEL_CKQUORUM,
+    Redis.SENTINEL_REPLICAS,
+    Redis.SENTINEL_SENTINELS,
     Redis.SMEMBERS,
     RedisTimeSeries.RANGE,
+    RedisTimeSeries.REVRANGE,
     RedisTimeSeries.GET,
     RedisTimeSeries.INFO,
     Redis.TTL,
@@ -44,6 +54,13 @@ export const CommandParameters = {
     Redis.XLEN,
     RediSearch.INFO,
     RediSearch.SEARCH,
+    RediSearch.AGGREGATE,
+    RediSearch.EXPLAIN,
+    RediSearch.EXPLAINCLI,
+    RediSearch.PROFILE,
+    RediSearch.TAGVALS,
+    RediSearch.SUGGET,
+    RediSearch.SPELLCHECK,
     Redis.XRANGE,
     Redis.XREVRANGE,
     RedisGraph.QUERY,
@@ -57,16 +74,27 @@ export const CommandParameters = {
     RedisJson.OBJLEN,
     RedisJson.ARRLEN,
   ],
-  legend: [RedisTimeSeries.RANGE],
-  legendLabel: [RedisTimeSeries.MRANGE, RedisTimeSeries.MGET],
+  legend: [RedisTimeSeries.RANGE, RedisTimeSeries.REVRANGE],
+  legendLabel: [RedisTimeSeries.MRANGE, RedisTimeSeries.MREVRANGE, RedisTimeSeries.MGET],
   section: [Redis.INFO],
-  value: [RedisTimeSeries.RANGE],
-  valueLabel: [RedisTimeSeries.MRANGE, RedisTimeSeries.MGET],
-  fill: [RedisTimeSeries.RANGE, RedisTimeSeries.MRANGE],
-  size: [Redis.SLOWLOG_GET, Redis.TMSCAN],
+  value: [RedisTimeSeries.RANGE, RedisTimeSeries.REVRANGE],
+  valueLabel: [RedisTimeSeries.MRANGE, RedisTimeSeries.MREVRANGE, RedisTimeSeries.MGET],
+  fill: [RedisTimeSeries.RANGE, RedisTimeSeries.REVRANGE, RedisTimeSeries.MRANGE, RedisTimeSeries.MREVRANGE],
+  size: [Redis.SLOWLOG_GET, Redis.TMSCAN, RedisTimeSeries.CARDINALITY],
   cursor: [Redis.TMSCAN],
-  match: [Redis.TMSCAN],
-  count: [Redis.TMSCAN, Redis.XRANGE, Redis.XREVRANGE],
+  match: [Redis.TMSCAN, Redis.CONFIG_GET],
+  compareNodes: [Redis.CONFIG_GET],
+  count: [
+    Redis.TMSCAN,
+    Redis.XRANGE,
+    Redis.XREVRANGE,
+    Redis.ACL_LOG,
+    RedisTimeSeries.RANGE,
+    RedisTimeSeries.REVRANGE,
+    RedisTimeSeries.MRANGE,
+    RedisTimeSeries.MREVRANGE,
+    RediSearch.SUGGET,
+  ],
   samples: [Redis.TMSCAN],
   min: [Redis.ZRANGE],
   max: [Redis.ZRANGE],
@@ -76,9 +104,36 @@ export const CommandParameters = {
   zrangeQuery: [Redis.ZRANGE],
   path: [RedisJson.TYPE, RedisJson.OBJKEYS, RedisJson.GET, RedisJson.OBJLEN, RedisJson.ARRLEN],
   pyFunction: [RedisGears.PYEXECUTE],
-  tsGroupBy: [RedisTimeSeries.MRANGE],
-  tsReducer: [RedisTimeSeries.MRANGE],
-  searchQuery: [RediSearch.SEARCH],
+  tsGroupBy: [RedisTimeSeries.MRANGE, RedisTimeSeries.MREVRANGE],
+  tsReducer: [RedisTimeSeries.MRANGE, RedisTimeSeries.MREVRANGE],
+  selectedLabels: [RedisTimeSeries.MRANGE, RedisTimeSeries.MREVRANGE, RedisTimeSeries.MGET],
+  debug: [RedisTimeSeries.INFO],
+  expression: [RedisTimeSeries.RANGE, RedisTimeSeries.REVRANGE, RedisTimeSeries.MRANGE, RedisTimeSeries.MREVRANGE],
+  tsFormat: [RedisTimeSeries.MRANGE, RedisTimeSeries.MREVRANGE, RedisTimeSeries.MGET],
+  tsRangeOptions: [RedisTimeSeries.RANGE, RedisTimeSeries.REVRANGE, RedisTimeSeries.MRANGE, RedisTimeSeries.MREVRANGE],
+  searchQuery: [
+    RediSearch.SEARCH,
+    RediSearch.AGGREGATE,
+    RediSearch.EXPLAIN,
+    RediSearch.EXPLAINCLI,
+    RediSearch.SPELLCHECK,
+  ],
+  searchPipeline: [RediSearch.AGGREGATE],
+  searchTimeField: [RediSearch.SEARCH, RediSearch.AGGREGATE],
+  searchTimeFilter: [RediSearch.SEARCH],
+  searchParams: [RediSearch.SEARCH, RediSearch.AGGREGATE],
+  searchProfile: [RediSearch.PROFILE],
+  dialect: [
+    RediSearch.SEARCH,
+    RediSearch.AGGREGATE,
+    RediSearch.EXPLAIN,
+    RediSearch.EXPLAINCLI,
+    RediSearch.SPELLCHECK,
+  ],
+  prefix: [RediSearch.SUGGET],
+  spellcheck: [RediSearch.SPELLCHECK],
+  searchOptions: [RediSearch.SEARCH],
+  searchCursor: [RediSearch.SEARCH, RediSearch.AGGREGATE],
   offset: [RediSearch.SEARCH],
   returnFields: [RediSearch.SEARCH],
   limit: [RediSearch.SEARCH],
@@ -4,9 +4,15 @@ import { SelectableValue } from '@grafana/data';
  * Supported Commands
  */
 export enum Redis {
+  ACL_LIST = 'aclList',
+  ACL_LOG = 'aclLog',
   CLIENT_LIST = 'clientList',
   CLUSTER_INFO = 'clusterInfo',
+  CLUSTER_GRAPH = 'clusterGraph',
   CLUSTER_NODES = 'clusterNodes',
+  CLUSTER_SHARDS = 'clusterShards',
+  CLUSTER_SLOTS = 'clusterSlots',
+  CONFIG_GET = 'configGet',
   GET = 'get',
   HGET = 'hget',
   HGETALL = 'hgetall',
@@ -17,6 +23,10 @@ export enum Redis {
   LLEN = 'llen',
   TMSCAN = 'tmscan',
   SCARD = 'scard',
+  SENTINEL_CKQUORUM = 'sentinelCkquorum',
+  SENTINEL_MASTERS = 'sentinelMasters',
+  SENTINEL_REPLICAS = 'sentinelReplicas',
+  SENTINEL_SENTINELS = 'sentinelSentinels',
   SLOWLOG_GET = 'slowlogGet',
   SMEMBERS = 'smembers',
   TTL = 'ttl',
@@ -32,6 +42,16 @@ export enum Redis {
  * Commands List
  */
 export const RedisCommands = [
+  {
+    label: 'ACL LIST',
+    description: 'Returns enabled state, key patterns and command categories for each user',
+    value: Redis.ACL_LIST,
+  },
+  {
+    label: 'ACL LOG',
+    description: 'Returns a list of recent ACL security events',
+    value: Redis.ACL_LOG,
+  },
   {
     label: 'CLIENT LIST',
     description: 'Returns information and statistics about the client connections server',
@@ -42,11 +62,31 @@ export const RedisCommands = [
     description: 'Provides INFO style information about Redis Cluster vital parameters',
     value: Redis.CLUSTER_INFO,
   },
+  {
+    label: 'CLUSTER NODES (Graph)',
+    description: 'Provides cluster topology with primaries, replicas and link state for the Node Graph panel',
+    value: Redis.CLUSTER_GRAPH,
+  },
   {
     label: 'CLUSTER NODES',
     description: 'Provides current cluster configuration, given by the set of known nodes',
     value: Redis.CLUSTER_NODES,
   },
+  {
+    label: 'CLUSTER SHARDS',
+    description: 'Returns slot ranges with primary, replicas and health, and hash slots coverage (Redis 7)',
+    value: Redis.CLUSTER_SHARDS,
+  },
+  {
+    label: 'CLUSTER SLOTS',
+    description: 'Returns slot ranges with primary and replicas, and hash slots coverage',
+    value: Redis.CLUSTER_SLOTS,
+  },
+  {
+    label: 'CONFIG GET',
+    description: 'Returns the values of configuration parameters, can be compared across all nodes',
+    value: Redis.CONFIG_GET,
+  },
   {
     label: Redis.GET.toUpperCase(),
     description: 'Returns the value of key',
@@ -93,6 +133,26 @@ export const RedisCommands = [
     description: 'Returns the set cardinality (number of elements) of the set stored at key',
     value: Redis.SCARD,
   },
+  {
+    label: 'SENTINEL CKQUORUM',
+    description: 'Check if Sentinels are able to reach the quorum needed to failover a master',
+    value: Redis.SENTINEL_CKQUORUM,
+  },
+  {
+    label: 'SENTINEL MASTERS',
+    description: 'Returns a list of monitored masters and their state',
+    value: Redis.SENTINEL_MASTERS,
+  },
+  {
+    label: 'SENTINEL REPLICAS',
+    description: 'Returns a list of replicas for the master and their state',
+    value: Redis.SENTINEL_REPLICAS,
+  },
+  {
+    label: 'SENTINEL SENTINELS',
+    description: 'Returns a list of Sentinel instances for the master and their state',
+    value: Redis.SENTINEL_SENTINELS,
+  },
   {
     label: 'SLOWLOG GET',
     description: 'Returns the Redis slow queries log',
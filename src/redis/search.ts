@@ -6,6 +6,14 @@ import { SelectableValue } from '@grafana/data';
 export enum RediSearch {
   INFO = 'ft.info',
   SEARCH = 'ft.search',
+  AGGREGATE = 'ft.aggregate',
+  EXPLAIN = 'ft.explain',
+  EXPLAINCLI = 'ft.explaincli',
+  PROFILE = 'ft.profile',
+  LIST = 'ft._list',
+  TAGVALS = 'ft.tagvals',
+  SUGGET = 'ft.sugget',
+  SPELLCHECK = 'ft.spellcheck',
 }
 
 /**
@@ -30,6 +38,46 @@ export const RediSearchCommands = [
     description: 'Search the index with a textual query, returning either documents or just ids',
     value: RediSearch.SEARCH,
   },
+  {
+    label: RediSearch.AGGREGATE.toUpperCase(),
+    description: 'Run a search query on an index and perform aggregate transformations on the results',
+    value: RediSearch.AGGREGATE,
+  },
+  {
+    label: RediSearch.EXPLAIN.toUpperCase(),
+    description: 'Returns the execution plan for a complex query',
+    value: RediSearch.EXPLAIN,
+  },
+  {
+    label: RediSearch.EXPLAINCLI.toUpperCase(),
+    description: 'Returns the execution plan for a complex query as an array of lines',
+    value: RediSearch.EXPLAINCLI,
+  },
+  {
+    label: RediSearch.PROFILE.toUpperCase(),
+    description: 'Performs a search or aggregate command and collects performance information',
+    value: RediSearch.PROFILE,
+  },
+  {
+    label: RediSearch.LIST.toUpperCase(),
+    description: 'Returns overview of all indexes with documents, memory and indexing failures',
+    value: RediSearch.LIST,
+  },
+  {
+    label: RediSearch.TAGVALS.toUpperCase(),
+    description: 'Returns the distinct tags indexed in a Tag field',
+    value: RediSearch.TAGVALS,
+  },
+  {
+    label: RediSearch.SUGGET.toUpperCase(),
+    description: 'Returns completion suggestions for a prefix with scores',
+    value: RediSearch.SUGGET,
+  },
+  {
+    label: RediSearch.SPELLCHECK.toUpperCase(),
+    description: 'Performs spelling correction on a query, returning suggestions for misspelled terms',
+    value: RediSearch.SPELLCHECK,
+  },
 ];
 
 export const SortDirection: Array<SelectableValue<SortDirectionValue>> = [
@@ -49,3 +97,145 @@ export const SortDirection: Array<SelectableValue<SortDirectionValue>> = [
     value: SortDirectionValue.DESC,
   },
 ];
+
+/**
+ * FT.PROFILE commands
+ */
+export enum SearchProfileValue {
+  SEARCH = 'SEARCH',
+  AGGREGATE = 'AGGREGATE',
+}
+
+export const SearchProfiles: Array<SelectableValue<SearchProfileValue>> = [
+  {
+    label: 'Search',
+    value: SearchProfileValue.SEARCH,
+  },
+  {
+    label: 'Aggregate',
+    value: SearchProfileValue.AGGREGATE,
+  },
+];
+
+/**
+ * FT.AGGREGATE pipeline steps
+ */
+export enum SearchStepValue {
+  LOAD = 'load',
+  GROUPBY = 'groupby',
+  APPLY = 'apply',
+  FILTER = 'filter',
+  SORTBY = 'sortby',
+  LIMIT = 'limit',
+}
+
+/**
+ * FT.AGGREGATE pipeline step
+ */
+export interface SearchStep {
+  type: SearchStepValue;
+  fields?: string[];
+  reducers?: SearchReducer[];
+  expression?: string;
+  as?: string;
+  offset?: number;
+  count?: number;
+  max?: number;
+}
+
+/**
+ * FT.AGGREGATE GROUPBY reducer
+ */
+export interface SearchReducer {
+  function: string;
+  args?: string[];
+  as?: string;
+}
+
+export const SearchSteps: Array<SelectableValue<SearchStepValue>> = [
+  {
+    label: 'Load',
+    description: 'Load document fields',
+    value: SearchStepValue.LOAD,
+  },
+  {
+    label: 'Group By',
+    description: 'Group the results by fields and reduce each group',
+    value: SearchStepValue.GROUPBY,
+  },
+  {
+    label: 'Apply',
+    description: 'Apply an expression and store the result as a new property',
+    value: SearchStepValue.APPLY,
+  },
+  {
+    label: 'Filter',
+    description: 'Filter the results using an expression',
+    value: SearchStepValue.FILTER,
+  },
+  {
+    label: 'Sort By',
+    description: 'Sort by properties, i.e. @count DESC',
+    value: SearchStepValue.SORTBY,
+  },
+  {
+    label: 'Limit',
+    description: 'Limit the number of results',
+    value: SearchStepValue.LIMIT,
+  },
+];
+
+/**
+ * Query parameter types
+ */
+export enum SearchParamTypeValue {
+  TEXT = 'text',
+  FLOAT32 = 'float32',
+  FLOAT64 = 'float64',
+}
+
+/**
+ * Query parameter
+ */
+export interface SearchParam {
+  name: string;
+  type?: SearchParamTypeValue;
+  value?: string;
+}
+
+export const SearchParamTypes: Array<SelectableValue<SearchParamTypeValue>> = [
+  {
+    label: 'Text',
+    description: 'Text or numeric value',
+    value: SearchParamTypeValue.TEXT,
+  },
+  {
+    label: 'Vector FLOAT32',
+    description: 'Array of floats, i.e. [0.1, 0.2], or base64 encoded blob',
+    value: SearchParamTypeValue.FLOAT32,
+  },
+  {
+    label: 'Vector FLOAT64',
+    description: 'Array of floats, i.e. [0.1, 0.2], or base64 encoded blob',
+    value: SearchParamTypeValue.FLOAT64,
+  },
+];
+
+/**
+ * Units of the timestamp field
+ */
+export enum SearchTimeUnitValue {
+  SECONDS = 's',
+  MILLISECONDS = 'ms',
+}
+
+export const SearchTimeUnits: Array<SelectableValue<SearchTimeUnitValue>> = [
+  {
+    label: 'Milliseconds',
+    value: SearchTimeUnitValue.MILLISECONDS,
+  },
+  {
+    label: 'Seconds',
+    value: SearchTimeUnitValue.SECONDS,
+  },
+];
@@ -10,6 +10,9 @@ export enum RedisTimeSeries {
   MRANGE = 'ts.mrange',
   QUERYINDEX = 'ts.queryindex',
   RANGE = 'ts.range',
+  MREVRANGE = 'ts.mrevrange',
+  REVRANGE = 'ts.revrange',
+  CARDINALITY = 'tscardinality',
 }
 
 /**
@@ -42,6 +45,21 @@ export const RedisTimeSeriesCommands = [
     value: RedisTimeSeries.QUERYINDEX,
   },
   { label: RedisTimeSeries.RANGE.toUpperCase(), description: 'Query a range', value: RedisTimeSeries.RANGE },
+  {
+    label: RedisTimeSeries.MREVRANGE.toUpperCase(),
+    description: 'Query a range across multiple time-series by filters in reverse direction',
+    value: RedisTimeSeries.MREVRANGE,
+  },
+  {
+    label: RedisTimeSeries.REVRANGE.toUpperCase(),
+    description: 'Query a range in reverse direction',
+    value: RedisTimeSeries.REVRANGE,
+  },
+  {
+    label: RedisTimeSeries.CARDINALITY.toUpperCase(),
+    description: 'Returns number of time-series, samples and memory per label and label value',
+    value: RedisTimeSeries.CARDINALITY,
+  },
 ];
 
 /**
@@ -76,6 +94,84 @@ export enum ReducerValue {
   VARS = 'var.s',
 }
 
+/**
+ * Align Values
+ */
+export enum AlignValue {
+  NONE = '',
+  START = 'start',
+  END = 'end',
+}
+
+/**
+ * Bucket Timestamp Values
+ */
+export enum BucketTimestampValue {
+  NONE = '',
+  START = '-',
+  MID = '~',
+  END = '+',
+}
+
+/**
+ * Output Format Values
+ */
+export enum TsFormatValue {
+  MULTI = 'multi',
+  WIDE = 'wide',
+  LONG = 'long',
+}
+
+/**
+ * Output Formats
+ */
+export const TsFormats: Array<SelectableValue<TsFormatValue>> = [
+  { label: 'Multi', description: 'Frame for each time-series', value: TsFormatValue.MULTI },
+  { label: 'Wide', description: 'Single frame joined on time', value: TsFormatValue.WIDE },
+  { label: 'Long', description: 'Single table with label columns', value: TsFormatValue.LONG },
+];
+
+/**
+ * Fill Mode Values
+ */
+export enum FillModeValue {
+  NULL = 'null',
+  ZERO = 'zero',
+  PREVIOUS = 'previous',
+  LINEAR = 'linear',
+  VALUE = 'value',
+}
+
+/**
+ * Fill Modes
+ */
+export const FillModes: Array<SelectableValue<FillModeValue>> = [
+  { label: 'Zero', description: 'Fill with zero', value: FillModeValue.ZERO },
+  { label: 'Null', description: 'Leave gaps in the time-series', value: FillModeValue.NULL },
+  { label: 'Previous', description: 'Fill with the previous value', value: FillModeValue.PREVIOUS },
+  { label: 'Linear', description: 'Linear interpolation between values', value: FillModeValue.LINEAR },
+  { label: 'Value', description: 'Fill with a constant value', value: FillModeValue.VALUE },
+];
+
+/**
+ * Aligns
+ */
+export const Aligns: Array<SelectableValue<AlignValue>> = [
+  { label: 'Default', description: 'Aligned to 0', value: AlignValue.NONE },
+  { label: 'Start', description: 'Aligned to the start of the range', value: AlignValue.START },
+  { label: 'End', description: 'Aligned to the end of the range', value: AlignValue.END },
+];
+
+/**
+ * Bucket Timestamps
+ */
+export const BucketTimestamps: Array<SelectableValue<BucketTimestampValue>> = [
+  { label: 'Default', description: 'Start of the bucket', value: BucketTimestampValue.NONE },
+  { label: 'Start', description: 'Start of the bucket', value: BucketTimestampValue.START },
+  { label: 'Mid', description: 'Middle of the bucket', value: BucketTimestampValue.MID },
+  { label: 'End', description: 'End of the bucket', value: BucketTimestampValue.END },
+];
+
 /**
  * Aggregations
  */
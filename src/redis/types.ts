@@ -3,8 +3,8 @@ import { DataQuery } from '@grafana/data';
 import { StreamingDataType } from '../constants';
 import { InfoSectionValue } from './info';
 import { QueryTypeValue } from './query';
-import { AggregationValue } from './time-series';
-import { SortDirectionValue } from './search';
+import { AggregationValue, AlignValue, BucketTimestampValue, FillModeValue, TsFormatValue } from './time-series';
+import { SearchParam, SearchProfileValue, SearchStep, SearchTimeUnitValue, SortDirectionValue } from './search';
 
 /**
  * Redis Query
@@ -38,6 +38,202 @@ export interface RedisQuery extends DataQuery {
    */
   returnFields?: string[];
 
+  /**
+   * FT.AGGREGATE pipeline
+   *
+   * @type {SearchStep[]}
+   */
+  searchPipeline?: SearchStep[];
+
+  /**
+   * Numeric timestamp field returned as time and used for time buckets
+   *
+   * @type {string}
+   */
+  searchTimeField?: string;
+
+  /**
+   * Filter FT.SEARCH documents by the timestamp field in the time range
+   *
+   * @type {boolean}
+   */
+  searchTimeFilter?: boolean;
+
+  /**
+   * Units of the timestamp field
+   *
+   * @type {SearchTimeUnitValue}
+   */
+  searchTimeUnit?: SearchTimeUnitValue;
+
+  /**
+   * Query parameters
+   *
+   * @type {SearchParam[]}
+   */
+  searchParams?: SearchParam[];
+
+  /**
+   * Query dialect
+   *
+   * @type {number}
+   */
+  dialect?: number;
+
+  /**
+   * Command to profile
+   *
+   * @type {SearchProfileValue}
+   */
+  searchProfile?: SearchProfileValue;
+
+  /**
+   * Prefix for FT.SUGGET
+   *
+   * @type {string}
+   */
+  prefix?: string;
+
+  /**
+   * Fuzzy prefix search for FT.SUGGET
+   *
+   * @type {boolean}
+   */
+  fuzzy?: boolean;
+
+  /**
+   * Maximum Levenshtein distance for FT.SPELLCHECK
+   *
+   * @type {number}
+   */
+  distance?: number;
+
+  /**
+   * Dictionary with terms to include for FT.SPELLCHECK
+   *
+   * @type {string}
+   */
+  includeTerms?: string;
+
+  /**
+   * Dictionary with terms to exclude for FT.SPELLCHECK
+   *
+   * @type {string}
+   */
+  excludeTerms?: string;
+
+  /**
+   * Return relative score of each document
+   *
+   * @type {boolean}
+   */
+  withScores?: boolean;
+
+  /**
+   * Return only the key names
+   *
+   * @type {boolean}
+   */
+  noContent?: boolean;
+
+  /**
+   * Do not expand query terms using stemming
+   *
+   * @type {boolean}
+   */
+  verbatim?: boolean;
+
+  /**
+   * Keys to limit the search
+   *
+   * @type {string[]}
+   */
+  inKeys?: string[];
+
+  /**
+   * Fields to search in
+   *
+   * @type {string[]}
+   */
+  inFields?: string[];
+
+  /**
+   * Highlight matching terms
+   *
+   * @type {boolean}
+   */
+  highlight?: boolean;
+
+  /**
+   * Fields to highlight
+   *
+   * @type {string[]}
+   */
+  highlightFields?: string[];
+
+  /**
+   * Opening tag for highlighting
+   *
+   * @type {string}
+   */
+  highlightOpen?: string;
+
+  /**
+   * Closing tag for highlighting
+   *
+   * @type {string}
+   */
+  highlightClose?: string;
+
+  /**
+   * Return fragments around the matching terms
+   *
+   * @type {boolean}
+   */
+  summarize?: boolean;
+
+  /**
+   * Fields to summarize
+   *
+   * @type {string[]}
+   */
+  summarizeFields?: string[];
+
+  /**
+   * Number of fragments
+   *
+   * @type {number}
+   */
+  summarizeFrags?: number;
+
+  /**
+   * Number of words in the fragment
+   *
+   * @type {number}
+   */
+  summarizeLen?: number;
+
+  /**
+   * Separator between fragments
+   *
+   * @type {string}
+   */
+  summarizeSeparator?: string;
+
+  /**
+   * Read FT.AGGREGATE results using cursor
+   *
+   * @type {boolean}
+   */
+  withCursor?: boolean;
+
+  /**
+   * Idle time in milliseconds before the cursor is deleted
+   *
+   * @type {number}
+   */
+  maxIdle?: number;
+
   /**
    * offset into result set to start at
    */
@@ -109,6 +305,33 @@ export interface RedisQuery extends DataQuery {
    */
   tsGroupByLabel?: string;
 
+  /**
+   * Labels to return in an TS.MRANGE or TS.MGET instead of all labels.
+   */
+  selectedLabels?: string;
+
+  /**
+   * Output format for multiple time-series
+   *
+   * @type {TsFormatValue}
+   */
+  tsFormat?: TsFormatValue;
+
+  /**
+   * Debug information
+   *
+   * @type {boolean}
+   */
+  debug?: boolean;
+
+  /**
+   * Expression over time-series, i.e. rate, delta, moving_avg(n), ratio(a, b), percentile(p)
+   * Ratio and percentile require multiple time-series returned by TS.MRANGE or TS.MREVRANGE
+   *
+   * @type {string}
+   */
+  expression?: string;
+
   /**
    * ZRANGE Query
    *
@@ -124,6 +347,20 @@ export interface RedisQuery extends DataQuery {
    */
   bucket?: number;
 
+  /**
+   * Automatic bucket from interval and maximum data points
+   *
+   * @type {boolean}
+   */
+  autoBucket?: boolean;
+
+  /**
+   * Minimum bucket for automatic bucket
+   *
+   * @type {number}
+   */
+  minBucket?: number;
+
   /**
    * Fill
    *
@@ -131,6 +368,69 @@ export interface RedisQuery extends DataQuery {
    */
   fill?: boolean;
 
+  /**
+   * Fill mode
+   *
+   * @type {FillModeValue}
+   */
+  fillMode?: FillModeValue;
+
+  /**
+   * Fill value
+   *
+   * @type {number}
+   */
+  fillValue?: number;
+
+  /**
+   * Filter by timestamps
+   *
+   * @type {string}
+   */
+  filterByTs?: string;
+
+  /**
+   * Filter by value minimum
+   *
+   * @type {string}
+   */
+  filterByValueMin?: string;
+
+  /**
+   * Filter by value maximum
+   *
+   * @type {string}
+   */
+  filterByValueMax?: string;
+
+  /**
+   * Aggregation buckets alignment
+   *
+   * @type {AlignValue}
+   */
+  align?: AlignValue;
+
+  /**
+   * Latest sample of compacted time-series
+   *
+   * @type {boolean}
+   */
+  latest?: boolean;
+
+  /**
+   * Report empty buckets
+   *
+   * @type {boolean}
+   */
+  empty?: boolean;
+
+  /**
+   * Bucket timestamp
+   *
+   * @type {BucketTimestampValue}
+   */
+  bucketTimestamp?: BucketTimestampValue;
+
   /**
    * Legend label
    *
@@ -193,6 +493,13 @@ export interface RedisQuery extends DataQuery {
    */
   match?: string;
 
+  /**
+   * Run command on all nodes and compare results
+   *
+   * @type {boolean}
+   */
+  compareNodes?: boolean;
+
   /**
    * Count for SCAN command
    *